@@ -309,10 +309,14 @@ func Provider() *schema.Provider {
 			"ibm_container_alb_cert":                       kubernetes.DataSourceIBMContainerALBCert(),
 			"ibm_container_ingress_instance":               kubernetes.DataSourceIBMContainerIngressInstance(),
 			"ibm_container_ingress_secret_tls":             kubernetes.DataSourceIBMContainerIngressSecretTLS(),
+			"ibm_container_ingress_domain":                 kubernetes.DataSourceIBMContainerIngressDomain(),
+			"ibm_container_ingress_health_check":           kubernetes.DataSourceIBMContainerIngressHealthCheck(),
 			"ibm_container_ingress_secret_opaque":          kubernetes.DataSourceIBMContainerIngressSecretOpaque(),
 			"ibm_container_bind_service":                   kubernetes.DataSourceIBMContainerBindService(),
 			"ibm_container_cluster":                        kubernetes.DataSourceIBMContainerCluster(),
 			"ibm_container_cluster_config":                 kubernetes.DataSourceIBMContainerClusterConfig(),
+			"ibm_container_cluster_credentials":            kubernetes.DataSourceIBMContainerClusterCredentials(),
+			"ibm_container_cluster_upgrade_plan":           kubernetes.DataSourceIBMContainerClusterUpgradePlan(),
 			"ibm_container_cluster_versions":               kubernetes.DataSourceIBMContainerClusterVersions(),
 			"ibm_container_cluster_worker":                 kubernetes.DataSourceIBMContainerClusterWorker(),
 			"ibm_container_nlb_dns":                        kubernetes.DataSourceIBMContainerNLBDNS(),
@@ -332,6 +336,10 @@ func Provider() *schema.Provider {
 			"ibm_cloud_shell_account_settings":             cloudshell.DataSourceIBMCloudShellAccountSettings(),
 			"ibm_cos_bucket":                               cos.DataSourceIBMCosBucket(),
 			"ibm_cos_bucket_object":                        cos.DataSourceIBMCosBucketObject(),
+			"ibm_cos_bucket_objects":                       cos.DataSourceIBMCosBucketObjects(),
+			"ibm_cos_presigned_url":                        cos.DataSourceIBMCosPresignedURL(),
+			"ibm_cos_bucket_cors_configuration":            cos.DataSourceIBMCOSBucketCorsConfiguration(),
+			"ibm_cos_bucket_public_access":                 cos.DataSourceIBMCOSBucketPublicAccess(),
 			"ibm_dns_domain_registration":                  classicinfrastructure.DataSourceIBMDNSDomainRegistration(),
 			"ibm_dns_domain":                               classicinfrastructure.DataSourceIBMDNSDomain(),
 			"ibm_dns_secondary":                            classicinfrastructure.DataSourceIBMDNSSecondary(),
@@ -453,6 +461,7 @@ func Provider() *schema.Provider {
 			"ibm_is_ike_policies":                    vpc.DataSourceIBMIsIkePolicies(),
 			"ibm_is_ike_policy":                      vpc.DataSourceIBMIsIkePolicy(),
 			"ibm_is_lb":                              vpc.DataSourceIBMISLB(),
+			"ibm_is_lb_health":                       vpc.DataSourceIBMISLBHealth(),
 			"ibm_is_lb_listener":                     vpc.DataSourceIBMISLBListener(),
 			"ibm_is_lb_listeners":                    vpc.DataSourceIBMISLBListeners(),
 			"ibm_is_lb_listener_policies":            vpc.DataSourceIBMISLBListenerPolicies(),
@@ -497,6 +506,7 @@ func Provider() *schema.Provider {
 			"ibm_is_virtual_network_interfaces":      vpc.DataSourceIBMIsVirtualNetworkInterfaces(),
 			"ibm_is_share_mount_target":              vpc.DataSourceIBMIsShareTarget(),
 			"ibm_is_share_mount_targets":             vpc.DataSourceIBMIsShareTargets(),
+			"ibm_is_share_accessor_bindings":         vpc.DataSourceIBMIsShareAccessorBindings(),
 			"ibm_is_volume":                          vpc.DataSourceIBMISVolume(),
 			"ibm_is_volumes":                         vpc.DataSourceIBMIsVolumes(),
 			"ibm_is_volume_profile":                  vpc.DataSourceIBMISVolumeProfile(),
@@ -541,6 +551,9 @@ func Provider() *schema.Provider {
 			"ibm_kms_key_policies":                   kms.DataSourceIBMKMSkeyPolicies(),
 			"ibm_kms_keys":                           kms.DataSourceIBMKMSkeys(),
 			"ibm_kms_key":                            kms.DataSourceIBMKMSkey(),
+			"ibm_kms_kmip_adapters":                  kms.DataSourceIBMKmsKMIPAdapters(),
+			"ibm_kms_kmip_client_certs":              kms.DataSourceIBMKmsKMIPClientCertificates(),
+			"ibm_kms_kmip_objects":                   kms.DataSourceIBMKmsKMIPObjects(),
 			"ibm_pn_application_chrome":              pushnotification.DataSourceIBMPNApplicationChrome(),
 			"ibm_app_config_environment":             appconfiguration.DataSourceIBMAppConfigEnvironment(),
 			"ibm_app_config_environments":            appconfiguration.DataSourceIBMAppConfigEnvironments(),
@@ -720,6 +733,7 @@ func Provider() *schema.Provider {
 
 			// //Added for Satellite
 			"ibm_satellite_location":                            satellite.DataSourceIBMSatelliteLocation(),
+			"ibm_satellite_location_health":                     satellite.DataSourceIBMSatelliteLocationHealth(),
 			"ibm_satellite_location_nlb_dns":                    satellite.DataSourceIBMSatelliteLocationNLBDNS(),
 			"ibm_satellite_attach_host_script":                  satellite.DataSourceIBMSatelliteAttachHostScript(),
 			"ibm_satellite_cluster":                             satellite.DataSourceIBMSatelliteCluster(),
@@ -917,6 +931,11 @@ func Provider() *schema.Provider {
 			"ibm_function_namespace":                       functions.ResourceIBMFunctionNamespace(),
 			"ibm_cis":                                      cis.ResourceIBMCISInstance(),
 			"ibm_database":                                 database.ResourceIBMDatabaseInstance(),
+			"ibm_database_allowlist_entry":                 database.ResourceIBMDatabaseAllowlistEntry(),
+			"ibm_database_backup":                          database.ResourceIBMDatabaseBackup(),
+			"ibm_database_configuration":                   database.ResourceIBMDatabaseConfiguration(),
+			"ibm_database_restore_test":                    database.ResourceIBMDatabaseRestoreTest(),
+			"ibm_database_user":                            database.ResourceIBMDatabaseUser(),
 			"ibm_cis_domain":                               cis.ResourceIBMCISDomain(),
 			"ibm_cis_domain_settings":                      cis.ResourceIBMCISSettings(),
 			"ibm_cis_firewall":                             cis.ResourceIBMCISFirewallRecord(),
@@ -975,8 +994,11 @@ func Provider() *schema.Provider {
 			"ibm_container_alb_cert":                       kubernetes.ResourceIBMContainerALBCert(),
 			"ibm_container_ingress_instance":               kubernetes.ResourceIBMContainerIngressInstance(),
 			"ibm_container_ingress_secret_tls":             kubernetes.ResourceIBMContainerIngressSecretTLS(),
+			"ibm_container_ingress_domain":                 kubernetes.ResourceIBMContainerIngressDomain(),
+			"ibm_container_ingress_health_check":           kubernetes.ResourceIBMContainerIngressHealthCheck(),
 			"ibm_container_ingress_secret_opaque":          kubernetes.ResourceIBMContainerIngressSecretOpaque(),
 			"ibm_container_cluster":                        kubernetes.ResourceIBMContainerCluster(),
+			"ibm_container_cluster_autoscaler":             kubernetes.ResourceIBMContainerClusterAutoscaler(),
 			"ibm_container_cluster_feature":                kubernetes.ResourceIBMContainerClusterFeature(),
 			"ibm_container_bind_service":                   kubernetes.ResourceIBMContainerBindService(),
 			"ibm_container_worker_pool":                    kubernetes.ResourceIBMContainerWorkerPool(),
@@ -994,6 +1016,10 @@ func Provider() *schema.Provider {
 			"ibm_cos_bucket_object":                        cos.ResourceIBMCOSBucketObject(),
 			"ibm_cos_bucket_object_lock_configuration":     cos.ResourceIBMCOSBucketObjectlock(),
 			"ibm_cos_bucket_website_configuration":         cos.ResourceIBMCOSBucketWebsiteConfiguration(),
+			"ibm_cos_bucket_cors_configuration":            cos.ResourceIBMCOSBucketCorsConfiguration(),
+			"ibm_cos_bucket_public_access":                 cos.ResourceIBMCOSBucketPublicAccess(),
+			"ibm_cos_bucket_objects_sync":                  cos.ResourceIBMCOSBucketObjectsSync(),
+			"ibm_cos_bucket_lifecycle_configuration":       cos.ResourceIBMCOSBucketLifecycleConfiguration(),
 			"ibm_dns_domain":                               classicinfrastructure.ResourceIBMDNSDomain(),
 			"ibm_dns_domain_registration_nameservers":      classicinfrastructure.ResourceIBMDNSDomainRegistrationNameservers(),
 			"ibm_dns_secondary":                            classicinfrastructure.ResourceIBMDNSSecondary(),
@@ -1004,6 +1030,7 @@ func Provider() *schema.Provider {
 			"ibm_firewall_policy":                          classicinfrastructure.ResourceIBMFirewallPolicy(),
 			"ibm_hpcs":                                     hpcs.ResourceIBMHPCS(),
 			"ibm_hpcs_managed_key":                         hpcs.ResourceIbmManagedKey(),
+			"ibm_hpcs_master_key_rotation":                 hpcs.ResourceIBMHPCSMasterKeyRotation(),
 			"ibm_hpcs_key_template":                        hpcs.ResourceIbmKeyTemplate(),
 			"ibm_hpcs_keystore":                            hpcs.ResourceIbmKeystore(),
 			"ibm_hpcs_vault":                               hpcs.ResourceIbmVault(),
@@ -1141,6 +1168,10 @@ func Provider() *schema.Provider {
 			"ibm_kms_key_alias":                             kms.ResourceIBMKmskeyAlias(),
 			"ibm_kms_key_rings":                             kms.ResourceIBMKmskeyRings(),
 			"ibm_kms_key_policies":                          kms.ResourceIBMKmskeyPolicies(),
+			"ibm_kms_import_token":                          kms.ResourceIBMKmsImportToken(),
+			"ibm_kms_key_action":                            kms.ResourceIBMKmsKeyAction(),
+			"ibm_kms_kmip_adapter":                          kms.ResourceIBMKmsKMIPAdapter(),
+			"ibm_kms_kmip_client_cert":                      kms.ResourceIBMKmsKMIPClientCertificate(),
 			"ibm_kp_key":                                    kms.ResourceIBMkey(),
 			"ibm_kms_instance_policies":                     kms.ResourceIBMKmsInstancePolicy(),
 			"ibm_resource_group":                            resourcemanager.ResourceIBMResourceGroup(),
@@ -1271,6 +1302,7 @@ func Provider() *schema.Provider {
 			// satellite  resources
 			"ibm_satellite_location":                            satellite.ResourceIBMSatelliteLocation(),
 			"ibm_satellite_host":                                satellite.ResourceIBMSatelliteHost(),
+			"ibm_satellite_host_attachment":                     satellite.ResourceIBMSatelliteHostAttachment(),
 			"ibm_satellite_cluster":                             satellite.ResourceIBMSatelliteCluster(),
 			"ibm_satellite_cluster_worker_pool":                 satellite.ResourceIBMSatelliteClusterWorkerPool(),
 			"ibm_satellite_link":                                satellite.ResourceIBMSatelliteLink(),
@@ -1551,6 +1583,7 @@ func Validator() validate.ValidatorDict {
 				"ibm_is_vpn_server":                       vpc.ResourceIBMIsVPNServerValidator(),
 				"ibm_is_vpn_server_route":                 vpc.ResourceIBMIsVPNServerRouteValidator(),
 				"ibm_kms_key_rings":                       kms.ResourceIBMKeyRingValidator(),
+				"ibm_kms_kmip_client_cert":                kms.ResourceIBMKmsKMIPClientCertificateValidator(),
 				"ibm_dns_glb_monitor":                     dnsservices.ResourceIBMPrivateDNSGLBMonitorValidator(),
 				"ibm_dns_custom_resolver_forwarding_rule": dnsservices.ResourceIBMPrivateDNSForwardingRuleValidator(),
 				"ibm_schematics_action":                   schematics.ResourceIBMSchematicsActionValidator(),
@@ -1625,7 +1658,10 @@ func Validator() validate.ValidatorDict {
 				"ibm_container_alb_cert":                    kubernetes.ResourceIBMContainerALBCertValidator(),
 				"ibm_container_ingress_instance":            kubernetes.ResourceIBMContainerIngressInstanceValidator(),
 				"ibm_container_ingress_secret_tls":          kubernetes.ResourceIBMContainerIngressSecretTLSValidator(),
+				"ibm_container_ingress_domain":              kubernetes.ResourceIBMContainerIngressDomainValidator(),
+				"ibm_container_ingress_health_check":        kubernetes.ResourceIBMContainerIngressHealthCheckValidator(),
 				"ibm_container_ingress_secret_opaque":       kubernetes.ResourceIBMContainerIngressSecretOpaqueValidator(),
+				"ibm_container_cluster_autoscaler":          kubernetes.ResourceIBMContainerClusterAutoscalerValidator(),
 				"ibm_container_cluster_feature":             kubernetes.ResourceIBMContainerClusterFeatureValidator(),
 
 				"ibm_iam_access_group_dynamic_rule":        iamaccessgroup.ResourceIBMIAMDynamicRuleValidator(),
@@ -1732,6 +1768,9 @@ func Validator() validate.ValidatorDict {
 				"ibm_container_worker_pool":             kubernetes.DataSourceIBMContainerWorkerPoolValidator(),
 				"ibm_container_bind_service":            kubernetes.DataSourceIBMContainerBindServiceValidator(),
 				"ibm_container_cluster_config":          kubernetes.DataSourceIBMContainerClusterConfigValidator(),
+				"ibm_container_cluster_credentials":     kubernetes.DataSourceIBMContainerClusterCredentialsValidator(),
+				"ibm_container_cluster_upgrade_plan":    kubernetes.DataSourceIBMContainerClusterUpgradePlanValidator(),
+				"ibm_satellite_location_health":         satellite.DataSourceIBMSatelliteLocationHealthValidator(),
 				"ibm_container_cluster":                 kubernetes.DataSourceIBMContainerClusterValidator(),
 				"ibm_container_vpc_cluster_worker":      kubernetes.DataSourceIBMContainerVPCClusterWorkerValidator(),
 				"ibm_container_vpc_cluster":             kubernetes.DataSourceIBMContainerVPCClusterValidator(),
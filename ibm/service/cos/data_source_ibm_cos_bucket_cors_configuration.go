@@ -0,0 +1,106 @@
+package cos
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	"github.com/IBM/ibm-cos-sdk-go/aws"
+	"github.com/IBM/ibm-cos-sdk-go/service/s3"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func DataSourceIBMCOSBucketCorsConfiguration() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceIBMCOSBucketCorsConfigurationRead,
+
+		Schema: map[string]*schema.Schema{
+			"bucket_crn": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "COS bucket CRN",
+			},
+			"bucket_location": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "COS bucket location",
+			},
+			"endpoint_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validate.ValidateAllowedStringValues([]string{"public", "private", "direct"}),
+				Description:  "COS endpoint type: public, private, direct",
+				Default:      "public",
+			},
+			"cors_rule": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The cross-origin access rules of the bucket.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"allowed_headers": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "Headers that are allowed in a preflight OPTIONS request through the Access-Control-Request-Headers header.",
+						},
+						"allowed_methods": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "HTTP methods that the origin is allowed to execute.",
+						},
+						"allowed_origins": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "Origins that are allowed to access the bucket.",
+						},
+						"expose_headers": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "Headers in the response that customers are able to access from their applications.",
+						},
+						"max_age_seconds": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "The time in seconds that the browser caches the preflight response.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceIBMCOSBucketCorsConfigurationRead(d *schema.ResourceData, meta interface{}) error {
+	bucketCRN := d.Get("bucket_crn").(string)
+	bucketName := strings.Split(bucketCRN, ":bucket:")[1]
+	instanceCRN := fmt.Sprintf("%s::", strings.Split(bucketCRN, ":bucket:")[0])
+	bucketLocation := d.Get("bucket_location").(string)
+	endpointType := d.Get("endpoint_type").(string)
+	bxSession, err := meta.(conns.ClientSession).BluemixSession()
+	if err != nil {
+		return err
+	}
+	s3Client, err := getS3ClientSession(bxSession, bucketLocation, endpointType, instanceCRN)
+	if err != nil {
+		return err
+	}
+	getBucketCorsInput := &s3.GetBucketCorsInput{
+		Bucket: aws.String(bucketName),
+	}
+	output, err := s3Client.GetBucketCors(getBucketCorsInput)
+	if err != nil && !strings.Contains(err.Error(), "NoSuchCORSConfiguration") {
+		return fmt.Errorf("failed to get the cors configuration of the COS bucket %s, %v", bucketName, err)
+	}
+	d.SetId(fmt.Sprintf("%s:%s:%s:meta:%s:%s", strings.Replace(instanceCRN, "::", "", -1), "bucket", bucketName, bucketLocation, endpointType))
+	if output != nil {
+		d.Set("cors_rule", corsRulesGet(output.CORSRules))
+	} else {
+		d.Set("cors_rule", []map[string]interface{}{})
+	}
+	return nil
+}
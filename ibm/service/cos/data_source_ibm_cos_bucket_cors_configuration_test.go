@@ -0,0 +1,38 @@
+package cos_test
+
+import (
+	"fmt"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIBMCosBucketCorsConfigurationDataSource_basic(t *testing.T) {
+	serviceName := fmt.Sprintf("terraform_%d", acctest.RandIntRange(10, 100))
+	bucketName := fmt.Sprintf("terraform-cors%d", acctest.RandIntRange(10, 100))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMCosBucketCorsConfigurationDataSourceConfig(serviceName, bucketName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.ibm_cos_bucket_cors_configuration.cors", "cors_rule.#", "1"),
+					resource.TestCheckResourceAttr("data.ibm_cos_bucket_cors_configuration.cors", "cors_rule.0.allowed_origins.0", "https://example.com"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMCosBucketCorsConfigurationDataSourceConfig(cosServiceName string, bucketName string) string {
+	return testAccCheckIBMCosBucket_Cors_Configuration_Basic(cosServiceName, bucketName, "us", "standard", "https://example.com", 3000) + `
+	data "ibm_cos_bucket_cors_configuration" "cors" {
+		bucket_crn      = ibm_cos_bucket_cors_configuration.cors.bucket_crn
+		bucket_location = ibm_cos_bucket_cors_configuration.cors.bucket_location
+	}
+	`
+}
@@ -0,0 +1,375 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package cos
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	"github.com/IBM/ibm-cos-sdk-go/aws"
+	"github.com/IBM/ibm-cos-sdk-go/service/s3"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	validation "github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// cosListObjectsPageSize is the maximum number of keys that COS returns in a single list request.
+const cosListObjectsPageSize = 1000
+
+func DataSourceIBMCosBucketObjects() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceIBMCosBucketObjectsRead,
+
+		Schema: map[string]*schema.Schema{
+			"bucket_crn": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "COS bucket CRN",
+			},
+			"bucket_location": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "COS bucket location",
+			},
+			"endpoint_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validate.ValidateAllowedStringValues([]string{"public", "private", "direct"}),
+				Description:  "COS endpoint type: public, private, direct",
+				Default:      "public",
+			},
+			"prefix": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Limits the response to keys that begin with the prefix",
+			},
+			"delimiter": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Character used to group keys. Keys that contain the delimiter after the prefix are returned as common prefixes",
+			},
+			"start_after": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Key after which the listing starts",
+			},
+			"version_id_marker": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				RequiredWith: []string{"start_after", "include_versions"},
+				Description:  "Version of the start_after key after which the listing of versions starts",
+			},
+			"max_keys": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      0,
+				ValidateFunc: validation.IntAtLeast(0),
+				Description:  "Maximum number of keys to return. All keys are returned if set to 0",
+			},
+			"include_versions": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "List all versions and delete markers of the objects instead of the current objects",
+			},
+			"objects": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The objects of the bucket. Empty if include_versions is set",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"key": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "COS object key",
+						},
+						"size": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "COS object size in bytes",
+						},
+						"etag": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "COS object ETag",
+						},
+						"last_modified": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "COS object last modified date",
+						},
+						"storage_class": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "COS object storage class",
+						},
+					},
+				},
+			},
+			"versions": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The object versions and delete markers of the bucket. Only set if include_versions is set",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"key": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "COS object key",
+						},
+						"version_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "COS object version ID",
+						},
+						"is_latest": {
+							Type:        schema.TypeBool,
+							Computed:    true,
+							Description: "Whether the version is the current version of the object",
+						},
+						"is_delete_marker": {
+							Type:        schema.TypeBool,
+							Computed:    true,
+							Description: "Whether the version is a delete marker",
+						},
+						"size": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "COS object size in bytes",
+						},
+						"etag": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "COS object ETag",
+						},
+						"last_modified": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "COS object last modified date",
+						},
+						"storage_class": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "COS object storage class",
+						},
+					},
+				},
+			},
+			"common_prefixes": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Key prefixes up to the first delimiter after the prefix",
+			},
+			"key_count": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Number of objects or versions that are returned",
+			},
+			"is_truncated": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether more keys are available than were returned because of max_keys",
+			},
+			"next_start_after": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Value for start_after to list the next keys if the result is truncated",
+			},
+			"next_version_id_marker": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Value for version_id_marker to list the next versions if the result is truncated",
+			},
+			"latest_object_key": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Key of the most recently modified object that is returned",
+			},
+		},
+	}
+}
+
+// remainingCOSListKeys returns the page size for the next list request.
+func remainingCOSListKeys(maxKeys, listed int) int64 {
+	if maxKeys > 0 && maxKeys-listed < cosListObjectsPageSize {
+		return int64(maxKeys - listed)
+	}
+	return cosListObjectsPageSize
+}
+
+// lastCOSListKey returns the last key or common prefix of a page of a listing. Keys and common prefixes are listed
+// in key order, so the greater of the last key and the last common prefix is where the page ends.
+func lastCOSListKey(contents []*s3.Object, commonPrefixes []*s3.CommonPrefix) string {
+	last := ""
+	if len(contents) > 0 {
+		last = aws.StringValue(contents[len(contents)-1].Key)
+	}
+	if len(commonPrefixes) > 0 {
+		if prefix := aws.StringValue(commonPrefixes[len(commonPrefixes)-1].Prefix); prefix > last {
+			last = prefix
+		}
+	}
+	return last
+}
+
+func dataSourceIBMCosBucketObjectsRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	bucketCRN := d.Get("bucket_crn").(string)
+	bucketName := strings.Split(bucketCRN, ":bucket:")[1]
+	instanceCRN := fmt.Sprintf("%s::", strings.Split(bucketCRN, ":bucket:")[0])
+
+	bucketLocation := d.Get("bucket_location").(string)
+	endpointType := d.Get("endpoint_type").(string)
+
+	bxSession, err := m.(conns.ClientSession).BluemixSession()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	s3Client, err := getS3Client(bxSession, bucketLocation, endpointType, instanceCRN)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	prefix := d.Get("prefix").(string)
+	delimiter := d.Get("delimiter").(string)
+	startAfter := d.Get("start_after").(string)
+	maxKeys := d.Get("max_keys").(int)
+
+	objects := make([]map[string]interface{}, 0)
+	versions := make([]map[string]interface{}, 0)
+	commonPrefixes := make([]string, 0)
+	truncated := false
+	nextStartAfter := ""
+	nextVersionIdMarker := ""
+	latestKey := ""
+	var latestModified time.Time
+
+	if d.Get("include_versions").(bool) {
+		input := &s3.ListObjectVersionsInput{
+			Bucket: aws.String(bucketName),
+		}
+		if prefix != "" {
+			input.Prefix = aws.String(prefix)
+		}
+		if delimiter != "" {
+			input.Delimiter = aws.String(delimiter)
+		}
+		if startAfter != "" {
+			input.KeyMarker = aws.String(startAfter)
+		}
+		if versionIdMarker, ok := d.GetOk("version_id_marker"); ok {
+			input.VersionIdMarker = aws.String(versionIdMarker.(string))
+		}
+		for {
+			input.MaxKeys = aws.Int64(remainingCOSListKeys(maxKeys, len(versions)))
+			out, err := s3Client.ListObjectVersionsWithContext(ctx, input)
+			if err != nil {
+				return diag.FromErr(fmt.Errorf("failed listing object versions of COS bucket (%s): %w", bucketName, err))
+			}
+			for _, v := range out.Versions {
+				versions = append(versions, map[string]interface{}{
+					"key":              aws.StringValue(v.Key),
+					"version_id":       aws.StringValue(v.VersionId),
+					"is_latest":        aws.BoolValue(v.IsLatest),
+					"is_delete_marker": false,
+					"size":             int(aws.Int64Value(v.Size)),
+					"etag":             strings.Trim(aws.StringValue(v.ETag), `"`),
+					"last_modified":    objectDatetoString(v.LastModified),
+					"storage_class":    aws.StringValue(v.StorageClass),
+				})
+				if aws.BoolValue(v.IsLatest) && v.LastModified != nil && v.LastModified.After(latestModified) {
+					latestModified = *v.LastModified
+					latestKey = aws.StringValue(v.Key)
+				}
+			}
+			for _, v := range out.DeleteMarkers {
+				versions = append(versions, map[string]interface{}{
+					"key":              aws.StringValue(v.Key),
+					"version_id":       aws.StringValue(v.VersionId),
+					"is_latest":        aws.BoolValue(v.IsLatest),
+					"is_delete_marker": true,
+					"last_modified":    objectDatetoString(v.LastModified),
+				})
+			}
+			for _, p := range out.CommonPrefixes {
+				commonPrefixes = append(commonPrefixes, aws.StringValue(p.Prefix))
+			}
+			truncated = aws.BoolValue(out.IsTruncated)
+			nextStartAfter = aws.StringValue(out.NextKeyMarker)
+			nextVersionIdMarker = aws.StringValue(out.NextVersionIdMarker)
+			if !truncated || (maxKeys > 0 && len(versions) >= maxKeys) {
+				break
+			}
+			input.KeyMarker = out.NextKeyMarker
+			input.VersionIdMarker = out.NextVersionIdMarker
+		}
+	} else {
+		input := &s3.ListObjectsV2Input{
+			Bucket: aws.String(bucketName),
+		}
+		if prefix != "" {
+			input.Prefix = aws.String(prefix)
+		}
+		if delimiter != "" {
+			input.Delimiter = aws.String(delimiter)
+		}
+		if startAfter != "" {
+			input.StartAfter = aws.String(startAfter)
+		}
+		for {
+			input.MaxKeys = aws.Int64(remainingCOSListKeys(maxKeys, len(objects)))
+			out, err := s3Client.ListObjectsV2WithContext(ctx, input)
+			if err != nil {
+				return diag.FromErr(fmt.Errorf("failed listing objects of COS bucket (%s): %w", bucketName, err))
+			}
+			for _, o := range out.Contents {
+				objects = append(objects, map[string]interface{}{
+					"key":           aws.StringValue(o.Key),
+					"size":          int(aws.Int64Value(o.Size)),
+					"etag":          strings.Trim(aws.StringValue(o.ETag), `"`),
+					"last_modified": objectDatetoString(o.LastModified),
+					"storage_class": aws.StringValue(o.StorageClass),
+				})
+				if o.LastModified != nil && o.LastModified.After(latestModified) {
+					latestModified = *o.LastModified
+					latestKey = aws.StringValue(o.Key)
+				}
+			}
+			for _, p := range out.CommonPrefixes {
+				commonPrefixes = append(commonPrefixes, aws.StringValue(p.Prefix))
+			}
+			truncated = aws.BoolValue(out.IsTruncated)
+			if last := lastCOSListKey(out.Contents, out.CommonPrefixes); last != "" {
+				nextStartAfter = last
+			}
+			if !truncated || (maxKeys > 0 && len(objects) >= maxKeys) {
+				break
+			}
+			input.ContinuationToken = out.NextContinuationToken
+		}
+	}
+	if !truncated {
+		nextStartAfter = ""
+		nextVersionIdMarker = ""
+	}
+
+	log.Printf("[DEBUG] Listed %d objects and %d versions of COS bucket (%s)", len(objects), len(versions), bucketName)
+
+	d.SetId(fmt.Sprintf("%s:objects:%s:location:%s", bucketCRN, prefix, bucketLocation))
+	d.Set("objects", objects)
+	d.Set("versions", versions)
+	d.Set("common_prefixes", commonPrefixes)
+	d.Set("key_count", len(objects)+len(versions))
+	d.Set("is_truncated", truncated)
+	d.Set("next_start_after", nextStartAfter)
+	d.Set("next_version_id_marker", nextVersionIdMarker)
+	d.Set("latest_object_key", latestKey)
+	return nil
+}
@@ -0,0 +1,43 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package cos
+
+import (
+	"testing"
+
+	"github.com/IBM/ibm-cos-sdk-go/aws"
+	"github.com/IBM/ibm-cos-sdk-go/service/s3"
+	"gotest.tools/assert"
+)
+
+func TestLastCOSListKey(t *testing.T) {
+	objects := func(keys ...string) []*s3.Object {
+		list := make([]*s3.Object, 0, len(keys))
+		for _, key := range keys {
+			list = append(list, &s3.Object{Key: aws.String(key)})
+		}
+		return list
+	}
+	prefixes := func(values ...string) []*s3.CommonPrefix {
+		list := make([]*s3.CommonPrefix, 0, len(values))
+		for _, value := range values {
+			list = append(list, &s3.CommonPrefix{Prefix: aws.String(value)})
+		}
+		return list
+	}
+	testcases := []struct {
+		contents       []*s3.Object
+		commonPrefixes []*s3.CommonPrefix
+		expected       string
+	}{
+		{contents: objects("a.txt", "b.txt"), expected: "b.txt"},
+		{commonPrefixes: prefixes("logs/", "photos/"), expected: "photos/"},
+		{contents: objects("a.txt", "z.txt"), commonPrefixes: prefixes("logs/"), expected: "z.txt"},
+		{contents: objects("a.txt"), commonPrefixes: prefixes("logs/", "photos/"), expected: "photos/"},
+		{expected: ""},
+	}
+	for _, tc := range testcases {
+		assert.Equal(t, lastCOSListKey(tc.contents, tc.commonPrefixes), tc.expected)
+	}
+}
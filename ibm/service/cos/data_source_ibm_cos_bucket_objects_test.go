@@ -0,0 +1,78 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package cos_test
+
+import (
+	"fmt"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIBMCOSBucketObjectsDataSource_basic(t *testing.T) {
+	name := "tf-testacc-cos-objects"
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheckCOS(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIBMCOSBucketObjectsDataSourceConfig_basic(name, acc.CosCRN),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.ibm_cos_bucket_objects.all", "id"),
+					resource.TestCheckResourceAttr("data.ibm_cos_bucket_objects.all", "objects.#", "3"),
+					resource.TestCheckResourceAttr("data.ibm_cos_bucket_objects.all", "objects.0.key", "releases/v1.0.0.txt"),
+					resource.TestCheckResourceAttrSet("data.ibm_cos_bucket_objects.all", "objects.0.etag"),
+					resource.TestCheckResourceAttrSet("data.ibm_cos_bucket_objects.all", "objects.0.last_modified"),
+					resource.TestCheckResourceAttrSet("data.ibm_cos_bucket_objects.all", "latest_object_key"),
+					resource.TestCheckResourceAttr("data.ibm_cos_bucket_objects.all", "is_truncated", "false"),
+					resource.TestCheckResourceAttr("data.ibm_cos_bucket_objects.page", "objects.#", "1"),
+					resource.TestCheckResourceAttr("data.ibm_cos_bucket_objects.page", "objects.0.key", "releases/v1.1.0.txt"),
+					resource.TestCheckResourceAttr("data.ibm_cos_bucket_objects.page", "is_truncated", "true"),
+					resource.TestCheckResourceAttr("data.ibm_cos_bucket_objects.page", "next_start_after", "releases/v1.1.0.txt"),
+					resource.TestCheckResourceAttr("data.ibm_cos_bucket_objects.folders", "common_prefixes.#", "1"),
+					resource.TestCheckResourceAttr("data.ibm_cos_bucket_objects.folders", "common_prefixes.0", "releases/"),
+				),
+			},
+		},
+	})
+}
+
+func testAccIBMCOSBucketObjectsDataSourceConfig_basic(name string, crn string) string {
+	return fmt.Sprintf(`
+		resource "ibm_cos_bucket" "testacc" {
+			bucket_name          = "%[1]s"
+			resource_instance_id = "%[2]s"
+			region_location      = "us-east"
+			storage_class        = "standard"
+		}
+		resource "ibm_cos_bucket_object" "testacc" {
+			for_each        = toset(["v1.0.0", "v1.1.0", "v1.2.0"])
+			bucket_crn      = ibm_cos_bucket.testacc.crn
+			bucket_location = ibm_cos_bucket.testacc.region_location
+			key             = "releases/${each.key}.txt"
+			content         = each.key
+		}
+		data "ibm_cos_bucket_objects" "all" {
+			depends_on      = [ibm_cos_bucket_object.testacc]
+			bucket_crn      = ibm_cos_bucket.testacc.crn
+			bucket_location = ibm_cos_bucket.testacc.region_location
+			prefix          = "releases/"
+		}
+		data "ibm_cos_bucket_objects" "page" {
+			depends_on      = [ibm_cos_bucket_object.testacc]
+			bucket_crn      = ibm_cos_bucket.testacc.crn
+			bucket_location = ibm_cos_bucket.testacc.region_location
+			prefix          = "releases/"
+			start_after     = "releases/v1.0.0.txt"
+			max_keys        = 1
+		}
+		data "ibm_cos_bucket_objects" "folders" {
+			depends_on      = [ibm_cos_bucket_object.testacc]
+			bucket_crn      = ibm_cos_bucket.testacc.crn
+			bucket_location = ibm_cos_bucket.testacc.region_location
+			delimiter       = "/"
+		}`, name, crn)
+}
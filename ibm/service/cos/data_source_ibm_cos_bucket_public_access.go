@@ -0,0 +1,69 @@
+package cos
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	"github.com/IBM/ibm-cos-sdk-go/aws"
+	"github.com/IBM/ibm-cos-sdk-go/service/s3"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func DataSourceIBMCOSBucketPublicAccess() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceIBMCOSBucketPublicAccessRead,
+
+		Schema: map[string]*schema.Schema{
+			"bucket_crn": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "COS bucket CRN",
+			},
+			"bucket_location": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "COS bucket location",
+			},
+			"endpoint_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validate.ValidateAllowedStringValues([]string{"public", "private", "direct"}),
+				Description:  "COS endpoint type: public, private, direct",
+				Default:      "public",
+			},
+			"public_read": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether anonymous users are allowed to read the objects of the bucket.",
+			},
+		},
+	}
+}
+
+func dataSourceIBMCOSBucketPublicAccessRead(d *schema.ResourceData, meta interface{}) error {
+	bucketCRN := d.Get("bucket_crn").(string)
+	bucketName := strings.Split(bucketCRN, ":bucket:")[1]
+	instanceCRN := fmt.Sprintf("%s::", strings.Split(bucketCRN, ":bucket:")[0])
+	bucketLocation := d.Get("bucket_location").(string)
+	endpointType := d.Get("endpoint_type").(string)
+	bxSession, err := meta.(conns.ClientSession).BluemixSession()
+	if err != nil {
+		return err
+	}
+	s3Client, err := getS3ClientSession(bxSession, bucketLocation, endpointType, instanceCRN)
+	if err != nil {
+		return err
+	}
+	getBucketAclInput := &s3.GetBucketAclInput{
+		Bucket: aws.String(bucketName),
+	}
+	output, err := s3Client.GetBucketAcl(getBucketAclInput)
+	if err != nil {
+		return fmt.Errorf("failed to get the access control list of the COS bucket %s, %v", bucketName, err)
+	}
+	d.SetId(fmt.Sprintf("%s:%s:%s:meta:%s:%s", strings.Replace(instanceCRN, "::", "", -1), "bucket", bucketName, bucketLocation, endpointType))
+	d.Set("public_read", bucketPublicRead(output.Grants))
+	return nil
+}
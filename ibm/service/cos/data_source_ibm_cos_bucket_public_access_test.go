@@ -0,0 +1,37 @@
+package cos_test
+
+import (
+	"fmt"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIBMCosBucketPublicAccessDataSource_basic(t *testing.T) {
+	serviceName := fmt.Sprintf("terraform_%d", acctest.RandIntRange(10, 100))
+	bucketName := fmt.Sprintf("terraform-public-access%d", acctest.RandIntRange(10, 100))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMCosBucketPublicAccessDataSourceConfig(serviceName, bucketName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.ibm_cos_bucket_public_access.public_access", "public_read", "true"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMCosBucketPublicAccessDataSourceConfig(cosServiceName string, bucketName string) string {
+	return testAccCheckIBMCosBucket_Public_Access_Basic(cosServiceName, bucketName, "us", "standard") + `
+	data "ibm_cos_bucket_public_access" "public_access" {
+		bucket_crn      = ibm_cos_bucket_public_access.public_access.bucket_crn
+		bucket_location = ibm_cos_bucket_public_access.public_access.bucket_location
+	}
+	`
+}
@@ -0,0 +1,172 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package cos
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"time"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	"github.com/IBM/ibm-cos-sdk-go/aws"
+	"github.com/IBM/ibm-cos-sdk-go/aws/credentials"
+	"github.com/IBM/ibm-cos-sdk-go/aws/request"
+	"github.com/IBM/ibm-cos-sdk-go/aws/session"
+	"github.com/IBM/ibm-cos-sdk-go/aws/signer"
+	v4 "github.com/IBM/ibm-cos-sdk-go/aws/signer/v4"
+	"github.com/IBM/ibm-cos-sdk-go/service/s3"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	validation "github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// cosPresignMaxExpiration is the longest validity of a SigV4 presigned URL.
+const cosPresignMaxExpiration = 7 * 24 * 60 * 60
+
+func DataSourceIBMCosPresignedURL() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceIBMCosPresignedURLRead,
+
+		Schema: map[string]*schema.Schema{
+			"bucket_name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "COS bucket name",
+			},
+			"bucket_location": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "COS bucket location",
+			},
+			"endpoint_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validate.ValidateAllowedStringValues([]string{"public", "private", "direct"}),
+				Description:  "COS endpoint type: public, private, direct",
+				Default:      "public",
+			},
+			"key": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "COS object key",
+			},
+			"method": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "GET",
+				ValidateFunc: validate.ValidateAllowedStringValues([]string{"GET", "PUT"}),
+				Description:  "HTTP method the URL is signed for: GET to download or PUT to upload the object",
+			},
+			"expiration": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      3600,
+				ValidateFunc: validation.IntBetween(1, cosPresignMaxExpiration),
+				Description:  "Number of seconds the URL is valid after the signing time",
+			},
+			"signing_time": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.IsRFC3339Time,
+				Description:  "Time in RFC 3339 format the URL is signed at. Defaults to the current time, which changes the URL on every read",
+			},
+			"access_key_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Sensitive:   true,
+				Description: "HMAC access key ID",
+			},
+			"secret_access_key": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Sensitive:   true,
+				Description: "HMAC secret access key",
+			},
+			"url": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Sensitive:   true,
+				Description: "The presigned URL",
+			},
+			"expires_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Time in RFC 3339 format after which the URL is no longer valid",
+			},
+		},
+	}
+}
+
+// presignCOSObjectURL computes a SigV4 presigned URL without calling COS.
+func presignCOSObjectURL(accessKeyID, secretAccessKey, bucketLocation, endpointType, bucketName, key, method string, signingTime time.Time, expiration time.Duration) (string, error) {
+	apiEndpoint := getCosEndpoint(bucketLocation, endpointType)
+	apiEndpoint = conns.EnvFallBack([]string{"IBMCLOUD_COS_ENDPOINT"}, apiEndpoint)
+	if apiEndpoint == "" {
+		return "", fmt.Errorf("the endpoint doesn't exists for given location %s and endpoint type %s", bucketLocation, endpointType)
+	}
+	s3Conf := aws.NewConfig().
+		WithEndpoint(apiEndpoint).
+		WithRegion(bucketLocation).
+		WithCredentials(credentials.NewStaticCredentials(accessKeyID, secretAccessKey, "")).
+		WithS3ForcePathStyle(true)
+	s3Sess, err := session.NewSession()
+	if err != nil {
+		return "", err
+	}
+	s3Client := s3.New(s3Sess, s3Conf)
+
+	var req *request.Request
+	switch method {
+	case "PUT":
+		req, _ = s3Client.PutObjectRequest(&s3.PutObjectInput{
+			Bucket: aws.String(bucketName),
+			Key:    aws.String(key),
+		})
+	default:
+		req, _ = s3Client.GetObjectRequest(&s3.GetObjectInput{
+			Bucket: aws.String(bucketName),
+			Key:    aws.String(key),
+		})
+	}
+	// Sign with a fixed time instead of the current time, so that the URL is stable between reads.
+	req.Handlers.Sign.Swap(signer.SignRequestHandler.Name, request.NamedHandler{
+		Name: signer.SignRequestHandler.Name,
+		Fn: func(r *request.Request) {
+			v4.SignSDKRequestWithCurrentTime(r, func() time.Time { return signingTime }, func(s *v4.Signer) {
+				s.DisableURIPathEscaping = true
+			})
+		},
+	})
+	return req.Presign(expiration)
+}
+
+func dataSourceIBMCosPresignedURLRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	bucketName := d.Get("bucket_name").(string)
+	bucketLocation := d.Get("bucket_location").(string)
+	endpointType := d.Get("endpoint_type").(string)
+	key := d.Get("key").(string)
+	method := d.Get("method").(string)
+	expiration := time.Duration(d.Get("expiration").(int)) * time.Second
+
+	signingTime := time.Now().UTC()
+	if v, ok := d.GetOk("signing_time"); ok {
+		signingTime, _ = time.Parse(time.RFC3339, v.(string))
+		signingTime = signingTime.UTC()
+	}
+
+	url, err := presignCOSObjectURL(d.Get("access_key_id").(string), d.Get("secret_access_key").(string), bucketLocation, endpointType, bucketName, key, method, signingTime, expiration)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("failed presigning COS bucket (%s) object (%s) URL: %w", bucketName, key, err))
+	}
+
+	// The ID must not reveal the signature, so it is derived from the URL.
+	hash := sha256.Sum256([]byte(url))
+	d.SetId(hex.EncodeToString(hash[:]))
+	d.Set("url", url)
+	d.Set("expires_at", signingTime.Add(expiration).Format(time.RFC3339))
+	return nil
+}
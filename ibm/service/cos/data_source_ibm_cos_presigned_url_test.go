@@ -0,0 +1,51 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package cos_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIBMCOSPresignedURLDataSource_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIBMCOSPresignedURLDataSourceConfig_basic("GET"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.ibm_cos_presigned_url.testacc", "id"),
+					resource.TestMatchResourceAttr("data.ibm_cos_presigned_url.testacc", "url", regexp.MustCompile(`^https://s3\.us-south\.cloud-object-storage\.appdomain\.cloud/my-bucket/releases/app\.tar\.gz\?X-Amz-Algorithm=AWS4-HMAC-SHA256&.*X-Amz-Date=20240101T000000Z&X-Amz-Expires=600&.*X-Amz-Signature=[0-9a-f]{64}$`)),
+					resource.TestCheckResourceAttr("data.ibm_cos_presigned_url.testacc", "expires_at", "2024-01-01T00:10:00Z"),
+				),
+			},
+			{
+				Config: testAccIBMCOSPresignedURLDataSourceConfig_basic("PUT"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestMatchResourceAttr("data.ibm_cos_presigned_url.testacc", "url", regexp.MustCompile(`X-Amz-Signature=[0-9a-f]{64}$`)),
+				),
+			},
+		},
+	})
+}
+
+func testAccIBMCOSPresignedURLDataSourceConfig_basic(method string) string {
+	return fmt.Sprintf(`
+		data "ibm_cos_presigned_url" "testacc" {
+			bucket_name       = "my-bucket"
+			bucket_location   = "us-south"
+			key               = "releases/app.tar.gz"
+			method            = "%s"
+			expiration        = 600
+			signing_time      = "2024-01-01T00:00:00Z"
+			access_key_id     = "0123456789abcdef0123456789abcdef"
+			secret_access_key = "0123456789abcdef0123456789abcdef0123456789abcdef"
+		}`, method)
+}
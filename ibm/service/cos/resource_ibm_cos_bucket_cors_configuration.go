@@ -0,0 +1,260 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package cos
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	"github.com/IBM/ibm-cos-sdk-go/aws"
+	"github.com/IBM/ibm-cos-sdk-go/service/s3"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	validation "github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func ResourceIBMCOSBucketCorsConfiguration() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceIBMCOSBucketCorsConfigurationCreate,
+		Read:   resourceIBMCOSBucketCorsConfigurationRead,
+		Update: resourceIBMCOSBucketCorsConfigurationUpdate,
+		Delete: resourceIBMCOSBucketCorsConfigurationDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceIBMCOSBucketConfigurationImport,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+			Update: schema.DefaultTimeout(20 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+		Schema: map[string]*schema.Schema{
+			"bucket_crn": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "COS bucket CRN",
+			},
+			"bucket_location": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "COS bucket location",
+			},
+			"endpoint_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validate.ValidateAllowedStringValues([]string{"public", "private", "direct"}),
+				Description:  "COS endpoint type: public, private, direct",
+				Default:      "public",
+			},
+			"cors_rule": {
+				Type:        schema.TypeList,
+				Required:    true,
+				MaxItems:    100,
+				Description: "The cross-origin access rules of the bucket.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"allowed_headers": {
+							Type:        schema.TypeList,
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "Headers that are allowed in a preflight OPTIONS request through the Access-Control-Request-Headers header.",
+						},
+						"allowed_methods": {
+							Type:     schema.TypeList,
+							Required: true,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validate.ValidateAllowedStringValues([]string{"GET", "PUT", "HEAD", "POST", "DELETE"}),
+							},
+							Description: "HTTP methods that the origin is allowed to execute: GET, PUT, HEAD, POST, DELETE.",
+						},
+						"allowed_origins": {
+							Type:        schema.TypeList,
+							Required:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "Origins that are allowed to access the bucket.",
+						},
+						"expose_headers": {
+							Type:        schema.TypeList,
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "Headers in the response that customers are able to access from their applications.",
+						},
+						"max_age_seconds": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntAtLeast(0),
+							Description:  "The time in seconds that the browser caches the preflight response.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func corsRulesSet(corsRuleList []interface{}) []*s3.CORSRule {
+	rules := make([]*s3.CORSRule, 0, len(corsRuleList))
+	for _, l := range corsRuleList {
+		ruleMap, _ := l.(map[string]interface{})
+		rule := s3.CORSRule{
+			AllowedMethods: aws.StringSlice(flex.ExpandStringList(ruleMap["allowed_methods"].([]interface{}))),
+			AllowedOrigins: aws.StringSlice(flex.ExpandStringList(ruleMap["allowed_origins"].([]interface{}))),
+		}
+		if headers, ok := ruleMap["allowed_headers"].([]interface{}); ok && len(headers) > 0 {
+			rule.AllowedHeaders = aws.StringSlice(flex.ExpandStringList(headers))
+		}
+		if headers, ok := ruleMap["expose_headers"].([]interface{}); ok && len(headers) > 0 {
+			rule.ExposeHeaders = aws.StringSlice(flex.ExpandStringList(headers))
+		}
+		if maxAge, ok := ruleMap["max_age_seconds"].(int); ok && maxAge > 0 {
+			rule.MaxAgeSeconds = aws.Int64(int64(maxAge))
+		}
+		rules = append(rules, &rule)
+	}
+	return rules
+}
+
+func corsRulesGet(rules []*s3.CORSRule) []map[string]interface{} {
+	corsRules := make([]map[string]interface{}, 0, len(rules))
+	for _, rule := range rules {
+		corsRule := map[string]interface{}{
+			"allowed_headers": aws.StringValueSlice(rule.AllowedHeaders),
+			"allowed_methods": aws.StringValueSlice(rule.AllowedMethods),
+			"allowed_origins": aws.StringValueSlice(rule.AllowedOrigins),
+			"expose_headers":  aws.StringValueSlice(rule.ExposeHeaders),
+		}
+		if rule.MaxAgeSeconds != nil {
+			corsRule["max_age_seconds"] = int(*rule.MaxAgeSeconds)
+		}
+		corsRules = append(corsRules, corsRule)
+	}
+	return corsRules
+}
+
+func resourceIBMCOSBucketCorsConfigurationCreate(d *schema.ResourceData, meta interface{}) error {
+	bucketCRN := d.Get("bucket_crn").(string)
+	bucketName := strings.Split(bucketCRN, ":bucket:")[1]
+	instanceCRN := fmt.Sprintf("%s::", strings.Split(bucketCRN, ":bucket:")[0])
+	bucketLocation := d.Get("bucket_location").(string)
+	endpointType := d.Get("endpoint_type").(string)
+	bxSession, err := meta.(conns.ClientSession).BluemixSession()
+	if err != nil {
+		return err
+	}
+	s3Client, err := getS3ClientSession(bxSession, bucketLocation, endpointType, instanceCRN)
+	if err != nil {
+		return err
+	}
+	putBucketCorsInput := &s3.PutBucketCorsInput{
+		Bucket: aws.String(bucketName),
+		CORSConfiguration: &s3.CORSConfiguration{
+			CORSRules: corsRulesSet(d.Get("cors_rule").([]interface{})),
+		},
+	}
+	_, err = s3Client.PutBucketCors(putBucketCorsInput)
+	if err != nil {
+		return fmt.Errorf("failed to put cors configuration on the COS bucket %s, %v", bucketName, err)
+	}
+	bktID := fmt.Sprintf("%s:%s:%s:meta:%s:%s", strings.Replace(instanceCRN, "::", "", -1), "bucket", bucketName, bucketLocation, endpointType)
+	d.SetId(bktID)
+	return resourceIBMCOSBucketCorsConfigurationRead(d, meta)
+}
+
+func resourceIBMCOSBucketCorsConfigurationUpdate(d *schema.ResourceData, meta interface{}) error {
+	bucketName := parseWebsiteId(d.Id(), "bucketName")
+	bucketLocation := parseWebsiteId(d.Id(), "bucketLocation")
+	instanceCRN := parseWebsiteId(d.Id(), "instanceCRN")
+	endpointType := d.Get("endpoint_type").(string)
+	bxSession, err := meta.(conns.ClientSession).BluemixSession()
+	if err != nil {
+		return err
+	}
+	s3Client, err := getS3ClientSession(bxSession, bucketLocation, endpointType, instanceCRN)
+	if err != nil {
+		return err
+	}
+	if d.HasChange("cors_rule") {
+		putBucketCorsInput := &s3.PutBucketCorsInput{
+			Bucket: aws.String(bucketName),
+			CORSConfiguration: &s3.CORSConfiguration{
+				CORSRules: corsRulesSet(d.Get("cors_rule").([]interface{})),
+			},
+		}
+		_, err = s3Client.PutBucketCors(putBucketCorsInput)
+		if err != nil {
+			return fmt.Errorf("failed to update cors configuration on the COS bucket %s, %v", bucketName, err)
+		}
+	}
+	if d.HasChange("endpoint_type") {
+		d.SetId(fmt.Sprintf("%s:meta:%s:%s", parseWebsiteId(d.Id(), "bucketCRN"), bucketLocation, endpointType))
+	}
+	return resourceIBMCOSBucketCorsConfigurationRead(d, meta)
+}
+
+func resourceIBMCOSBucketCorsConfigurationRead(d *schema.ResourceData, meta interface{}) error {
+	bucketCRN := parseWebsiteId(d.Id(), "bucketCRN")
+	bucketName := parseWebsiteId(d.Id(), "bucketName")
+	bucketLocation := parseWebsiteId(d.Id(), "bucketLocation")
+	instanceCRN := parseWebsiteId(d.Id(), "instanceCRN")
+	endpointType := parseWebsiteId(d.Id(), "endpointType")
+	d.Set("bucket_crn", bucketCRN)
+	d.Set("bucket_location", bucketLocation)
+	if endpointType != "" {
+		d.Set("endpoint_type", endpointType)
+	}
+	bxSession, err := meta.(conns.ClientSession).BluemixSession()
+	if err != nil {
+		return err
+	}
+	s3Client, err := getS3ClientSession(bxSession, bucketLocation, endpointType, instanceCRN)
+	if err != nil {
+		return err
+	}
+	getBucketCorsInput := &s3.GetBucketCorsInput{
+		Bucket: aws.String(bucketName),
+	}
+	output, err := s3Client.GetBucketCors(getBucketCorsInput)
+	if err != nil {
+		if strings.Contains(err.Error(), "NoSuchCORSConfiguration") {
+			d.SetId("")
+			return nil
+		}
+		if !strings.Contains(err.Error(), "AccessDenied: Access Denied") {
+			return err
+		}
+	}
+	if output != nil {
+		d.Set("cors_rule", corsRulesGet(output.CORSRules))
+	}
+	return nil
+}
+
+func resourceIBMCOSBucketCorsConfigurationDelete(d *schema.ResourceData, meta interface{}) error {
+	bucketName := parseWebsiteId(d.Id(), "bucketName")
+	bucketLocation := parseWebsiteId(d.Id(), "bucketLocation")
+	instanceCRN := parseWebsiteId(d.Id(), "instanceCRN")
+	endpointType := parseWebsiteId(d.Id(), "endpointType")
+	bxSession, err := meta.(conns.ClientSession).BluemixSession()
+	if err != nil {
+		return err
+	}
+	s3Client, err := getS3ClientSession(bxSession, bucketLocation, endpointType, instanceCRN)
+	if err != nil {
+		return err
+	}
+	deleteBucketCorsInput := &s3.DeleteBucketCorsInput{
+		Bucket: aws.String(bucketName),
+	}
+	_, err = s3Client.DeleteBucketCors(deleteBucketCorsInput)
+	if err != nil {
+		return fmt.Errorf("failed to delete the cors configuration on the COS bucket %s, %v", bucketName, err)
+	}
+	return nil
+}
@@ -0,0 +1,81 @@
+package cos_test
+
+import (
+	"fmt"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIBMCosBucket_Cors_Configuration_Basic(t *testing.T) {
+	serviceName := fmt.Sprintf("terraform_%d", acctest.RandIntRange(10, 100))
+	bucketName := fmt.Sprintf("terraform-cors%d", acctest.RandIntRange(10, 100))
+	bucketRegion := "us"
+	bucketClass := "standard"
+	bucketRegionType := "cross_region_location"
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckIBMCosBucketDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMCosBucket_Cors_Configuration_Basic(serviceName, bucketName, bucketRegion, bucketClass, "https://example.com", 3000),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckIBMCosBucketExists("ibm_resource_instance.instance", "ibm_cos_bucket.bucket", bucketRegionType, bucketRegion, bucketName),
+					resource.TestCheckResourceAttr("ibm_cos_bucket_cors_configuration.cors", "cors_rule.#", "1"),
+					resource.TestCheckResourceAttr("ibm_cos_bucket_cors_configuration.cors", "cors_rule.0.allowed_origins.0", "https://example.com"),
+					resource.TestCheckResourceAttr("ibm_cos_bucket_cors_configuration.cors", "cors_rule.0.allowed_methods.#", "2"),
+					resource.TestCheckResourceAttr("ibm_cos_bucket_cors_configuration.cors", "cors_rule.0.max_age_seconds", "3000"),
+				),
+			},
+			{
+				Config: testAccCheckIBMCosBucket_Cors_Configuration_Basic(serviceName, bucketName, bucketRegion, bucketClass, "https://www.example.com", 600),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_cos_bucket_cors_configuration.cors", "cors_rule.0.allowed_origins.0", "https://www.example.com"),
+					resource.TestCheckResourceAttr("ibm_cos_bucket_cors_configuration.cors", "cors_rule.0.max_age_seconds", "600"),
+				),
+			},
+			{
+				ResourceName:      "ibm_cos_bucket_cors_configuration.cors",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckIBMCosBucket_Cors_Configuration_Basic(cosServiceName string, bucketName string, region string, storageClass string, origin string, maxAge int) string {
+
+	return fmt.Sprintf(`
+	data "ibm_resource_group" "cos_group" {
+		name = "Default"
+	}
+
+	resource "ibm_resource_instance" "instance" {
+		name              = "%s"
+		service           = "cloud-object-storage"
+		plan              = "standard"
+		location          = "global"
+		resource_group_id = data.ibm_resource_group.cos_group.id
+	}
+	resource "ibm_cos_bucket" "bucket" {
+		bucket_name           = "%s"
+		resource_instance_id  = ibm_resource_instance.instance.id
+		cross_region_location = "%s"
+		storage_class         = "%s"
+	}
+	resource "ibm_cos_bucket_cors_configuration" "cors" {
+		bucket_crn      = ibm_cos_bucket.bucket.crn
+		bucket_location = ibm_cos_bucket.bucket.cross_region_location
+		cors_rule {
+			allowed_headers = ["*"]
+			allowed_methods = ["GET", "PUT"]
+			allowed_origins = ["%s"]
+			expose_headers  = ["ETag"]
+			max_age_seconds = %d
+		}
+	}
+	`, cosServiceName, bucketName, region, storageClass, origin, maxAge)
+}
@@ -0,0 +1,616 @@
+package cos
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	"github.com/IBM/ibm-cos-sdk-go/aws"
+	"github.com/IBM/ibm-cos-sdk-go/service/s3"
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	validation "github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func ResourceIBMCOSBucketLifecycleConfiguration() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceIBMCOSBucketLifecycleConfigurationCreate,
+		Read:   resourceIBMCOSBucketLifecycleConfigurationRead,
+		Update: resourceIBMCOSBucketLifecycleConfigurationUpdate,
+		Delete: resourceIBMCOSBucketLifecycleConfigurationDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceIBMCOSBucketConfigurationImport,
+		},
+		CustomizeDiff: resourceIBMCOSBucketLifecycleConfigurationDiff,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+			Update: schema.DefaultTimeout(20 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+		Schema: map[string]*schema.Schema{
+			"bucket_crn": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "COS bucket CRN",
+			},
+			"bucket_location": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "COS bucket location",
+			},
+			"endpoint_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validate.ValidateAllowedStringValues([]string{"public", "private", "direct"}),
+				Description:  "COS endpoint type: public, private, direct",
+				Default:      "public",
+			},
+			"lifecycle_rule": {
+				Type:        schema.TypeList,
+				Required:    true,
+				MaxItems:    1000,
+				Description: "The lifecycle rules of the bucket. The rules replace the complete lifecycle configuration of the bucket.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"rule_id": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringLenBetween(1, 255),
+							Description:  "Unique identifier for the rule.",
+						},
+						"status": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validate.ValidateAllowedStringValues([]string{s3.ExpirationStatusEnabled, s3.ExpirationStatusDisabled}),
+							Description:  "Whether the rule is applied: Enabled, Disabled.",
+						},
+						"filter": {
+							Type:        schema.TypeList,
+							Optional:    true,
+							MaxItems:    1,
+							Description: "The objects the rule applies to. The rule applies to all objects of the bucket if not set.",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"prefix": {
+										Type:        schema.TypeString,
+										Optional:    true,
+										Description: "The rule applies to any objects with keys that match this prefix.",
+									},
+									"tag": {
+										Type:        schema.TypeList,
+										Optional:    true,
+										Description: "The rule applies to any objects that have all of these tags.",
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"key": {
+													Type:        schema.TypeString,
+													Required:    true,
+													Description: "The key of the tag.",
+												},
+												"value": {
+													Type:        schema.TypeString,
+													Required:    true,
+													Description: "The value of the tag.",
+												},
+											},
+										},
+									},
+									"object_size_greater_than": {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										ValidateFunc: validation.IntAtLeast(0),
+										Description:  "The rule applies to any objects larger than this size in bytes.",
+									},
+									"object_size_less_than": {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										ValidateFunc: validation.IntAtLeast(1),
+										Description:  "The rule applies to any objects smaller than this size in bytes.",
+									},
+								},
+							},
+						},
+						"transition": {
+							Type:        schema.TypeList,
+							Optional:    true,
+							Description: "Transitions of the objects to an archive storage class.",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"days": {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										ValidateFunc: validate.ValidateAllowedRangeInt(0, 3650),
+										Description:  "Number of days after the creation of an object when it is transitioned.",
+									},
+									"date": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validate.ValidBucketLifecycleTimestamp,
+										Description:  "Date in YYYY-MM-DD format when the objects are transitioned.",
+									},
+									"storage_class": {
+										Type:             schema.TypeString,
+										Required:         true,
+										ValidateFunc:     validate.ValidateAllowedStringValues([]string{"GLACIER", "ACCELERATED", "Glacier", "Accelerated", "glacier", "accelerated"}),
+										DiffSuppressFunc: caseDiffSuppress,
+										Description:      "The archive storage class the objects are transitioned to: GLACIER, ACCELERATED.",
+									},
+								},
+							},
+						},
+						"expiration": {
+							Type:        schema.TypeList,
+							Optional:    true,
+							MaxItems:    1,
+							Description: "Expiration of the current version of the objects.",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"days": {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										ValidateFunc: validate.ValidateAllowedRangeInt(1, 3650),
+										Description:  "Number of days after the creation of an object when it expires.",
+									},
+									"date": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validate.ValidBucketLifecycleTimestamp,
+										Description:  "Date in YYYY-MM-DD format when the objects expire.",
+									},
+									"expired_object_delete_marker": {
+										Type:        schema.TypeBool,
+										Optional:    true,
+										Description: "Remove delete markers that have no noncurrent versions.",
+									},
+								},
+							},
+						},
+						"noncurrent_version_expiration": {
+							Type:        schema.TypeList,
+							Optional:    true,
+							MaxItems:    1,
+							Description: "Expiration of the noncurrent versions of the objects in a versioned bucket.",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"noncurrent_days": {
+										Type:         schema.TypeInt,
+										Required:     true,
+										ValidateFunc: validate.ValidateAllowedRangeInt(1, 3650),
+										Description:  "Number of days after a version becomes noncurrent when it expires.",
+									},
+								},
+							},
+						},
+						"abort_incomplete_multipart_upload": {
+							Type:        schema.TypeList,
+							Optional:    true,
+							MaxItems:    1,
+							Description: "Abort of incomplete multipart uploads.",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"days_after_initiation": {
+										Type:         schema.TypeInt,
+										Required:     true,
+										ValidateFunc: validate.ValidateAllowedRangeInt(1, 3650),
+										Description:  "Number of days after the initiation of an incomplete multipart upload when it is aborted.",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func lifecycleDate(date string) *time.Time {
+	t, _ := time.Parse(time.RFC3339, fmt.Sprintf("%sT00:00:00Z", date))
+	return aws.Time(t)
+}
+
+func lifecycleRuleFilterSet(filterMap map[string]interface{}) *s3.LifecycleRuleFilter {
+	and := &s3.LifecycleRuleAndOperator{}
+	conditions := 0
+	if prefix := filterMap["prefix"].(string); prefix != "" {
+		and.Prefix = aws.String(prefix)
+		conditions++
+	}
+	for _, t := range filterMap["tag"].([]interface{}) {
+		tagMap := t.(map[string]interface{})
+		and.Tags = append(and.Tags, &s3.Tag{
+			Key:   aws.String(tagMap["key"].(string)),
+			Value: aws.String(tagMap["value"].(string)),
+		})
+		conditions++
+	}
+	if size := filterMap["object_size_greater_than"].(int); size > 0 {
+		and.ObjectSizeGreaterThan = aws.Int64(int64(size))
+		conditions++
+	}
+	if size := filterMap["object_size_less_than"].(int); size > 0 {
+		and.ObjectSizeLessThan = aws.Int64(int64(size))
+		conditions++
+	}
+	// A filter with more than one condition must combine them with the And operator.
+	if conditions > 1 {
+		return &s3.LifecycleRuleFilter{And: and}
+	}
+	filter := &s3.LifecycleRuleFilter{
+		Prefix:                and.Prefix,
+		ObjectSizeGreaterThan: and.ObjectSizeGreaterThan,
+		ObjectSizeLessThan:    and.ObjectSizeLessThan,
+	}
+	if len(and.Tags) == 1 {
+		filter.Tag = and.Tags[0]
+	}
+	return filter
+}
+
+func lifecycleRulesSet(lifecycleRuleList []interface{}) []*s3.LifecycleRule {
+	rules := make([]*s3.LifecycleRule, 0, len(lifecycleRuleList))
+	for _, l := range lifecycleRuleList {
+		ruleMap, _ := l.(map[string]interface{})
+		rule := s3.LifecycleRule{
+			ID:     aws.String(ruleMap["rule_id"].(string)),
+			Status: aws.String(ruleMap["status"].(string)),
+			Filter: &s3.LifecycleRuleFilter{},
+		}
+		if filter, ok := ruleMap["filter"].([]interface{}); ok && len(filter) > 0 && filter[0] != nil {
+			rule.Filter = lifecycleRuleFilterSet(filter[0].(map[string]interface{}))
+		}
+		if transitions, ok := ruleMap["transition"].([]interface{}); ok {
+			for _, transition := range transitions {
+				if transition == nil {
+					continue
+				}
+				transitionMap := transition.(map[string]interface{})
+				t := &s3.Transition{
+					StorageClass: aws.String(strings.ToUpper(transitionMap["storage_class"].(string))),
+				}
+				if date := transitionMap["date"].(string); date != "" {
+					t.Date = lifecycleDate(date)
+				} else {
+					t.Days = aws.Int64(int64(transitionMap["days"].(int)))
+				}
+				rule.Transitions = append(rule.Transitions, t)
+			}
+		}
+		if expiration, ok := ruleMap["expiration"].([]interface{}); ok && len(expiration) > 0 && expiration[0] != nil {
+			expirationMap := expiration[0].(map[string]interface{})
+			e := &s3.LifecycleExpiration{}
+			if days := expirationMap["days"].(int); days > 0 {
+				e.Days = aws.Int64(int64(days))
+			}
+			if date := expirationMap["date"].(string); date != "" {
+				e.Date = lifecycleDate(date)
+			}
+			if expirationMap["expired_object_delete_marker"].(bool) {
+				e.ExpiredObjectDeleteMarker = aws.Bool(true)
+			}
+			rule.Expiration = e
+		}
+		if noncurrent, ok := ruleMap["noncurrent_version_expiration"].([]interface{}); ok && len(noncurrent) > 0 && noncurrent[0] != nil {
+			noncurrentMap := noncurrent[0].(map[string]interface{})
+			rule.NoncurrentVersionExpiration = &s3.NoncurrentVersionExpiration{
+				NoncurrentDays: aws.Int64(int64(noncurrentMap["noncurrent_days"].(int))),
+			}
+		}
+		if abort, ok := ruleMap["abort_incomplete_multipart_upload"].([]interface{}); ok && len(abort) > 0 && abort[0] != nil {
+			abortMap := abort[0].(map[string]interface{})
+			rule.AbortIncompleteMultipartUpload = &s3.AbortIncompleteMultipartUpload{
+				DaysAfterInitiation: aws.Int64(int64(abortMap["days_after_initiation"].(int))),
+			}
+		}
+		rules = append(rules, &rule)
+	}
+	return rules
+}
+
+// validateLifecycleRules checks the lifecycle rules of the configuration at plan time. Attributes whose values are
+// not known yet are not checked.
+func validateLifecycleRules(rules cty.Value) error {
+	if rules.IsNull() || !rules.IsKnown() {
+		return nil
+	}
+	for it := rules.ElementIterator(); it.Next(); {
+		_, rule := it.Element()
+		if rule.IsNull() || !rule.IsKnown() {
+			continue
+		}
+		ruleID := "(unknown)"
+		if id := rule.GetAttr("rule_id"); id.IsKnown() && !id.IsNull() {
+			ruleID = id.AsString()
+		}
+		actions, allKnown := 0, true
+		for _, action := range []string{"transition", "expiration", "noncurrent_version_expiration", "abort_incomplete_multipart_upload"} {
+			blocks := rule.GetAttr(action)
+			if !blocks.IsKnown() {
+				allKnown = false
+			} else if !blocks.IsNull() && blocks.LengthInt() > 0 {
+				actions++
+			}
+		}
+		if actions == 0 && allKnown {
+			return fmt.Errorf("lifecycle rule %s: at least one of transition, expiration, noncurrent_version_expiration or abort_incomplete_multipart_upload must be set", ruleID)
+		}
+		if err := validateLifecycleRuleBlocks(rule.GetAttr("transition"), []string{"days", "date"}); err != nil {
+			return fmt.Errorf("lifecycle rule %s: %s must be set in transition", ruleID, err)
+		}
+		if err := validateLifecycleRuleBlocks(rule.GetAttr("expiration"), []string{"days", "date", "expired_object_delete_marker"}); err != nil {
+			return fmt.Errorf("lifecycle rule %s: %s must be set in expiration", ruleID, err)
+		}
+	}
+	return nil
+}
+
+// validateLifecycleRuleBlocks checks that exactly one of the attributes is set in each of the blocks. An
+// expired_object_delete_marker of false is not set.
+func validateLifecycleRuleBlocks(blocks cty.Value, attributes []string) error {
+	if blocks.IsNull() || !blocks.IsKnown() {
+		return nil
+	}
+	for it := blocks.ElementIterator(); it.Next(); {
+		_, block := it.Element()
+		if block.IsNull() || !block.IsKnown() {
+			continue
+		}
+		set := 0
+		for _, attribute := range attributes {
+			value := block.GetAttr(attribute)
+			if !value.IsKnown() {
+				return nil
+			}
+			if !value.IsNull() && !value.RawEquals(cty.False) {
+				set++
+			}
+		}
+		if set != 1 {
+			return fmt.Errorf("exactly one of %s", strings.Join(attributes, ", "))
+		}
+	}
+	return nil
+}
+
+func resourceIBMCOSBucketLifecycleConfigurationDiff(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	return validateLifecycleRules(diff.GetRawConfig().GetAttr("lifecycle_rule"))
+}
+
+func lifecycleRuleFilterGet(f *s3.LifecycleRuleFilter) []map[string]interface{} {
+	if f == nil {
+		return nil
+	}
+	prefix, tags, greaterThan, lessThan := f.Prefix, []*s3.Tag{}, f.ObjectSizeGreaterThan, f.ObjectSizeLessThan
+	if f.Tag != nil {
+		tags = append(tags, f.Tag)
+	}
+	if f.And != nil {
+		prefix, tags, greaterThan, lessThan = f.And.Prefix, f.And.Tags, f.And.ObjectSizeGreaterThan, f.And.ObjectSizeLessThan
+	}
+	filter := map[string]interface{}{}
+	if aws.StringValue(prefix) != "" {
+		filter["prefix"] = aws.StringValue(prefix)
+	}
+	if len(tags) > 0 {
+		tagList := make([]map[string]interface{}, 0, len(tags))
+		for _, t := range tags {
+			tagList = append(tagList, map[string]interface{}{
+				"key":   aws.StringValue(t.Key),
+				"value": aws.StringValue(t.Value),
+			})
+		}
+		filter["tag"] = tagList
+	}
+	if greaterThan != nil {
+		filter["object_size_greater_than"] = int(*greaterThan)
+	}
+	if lessThan != nil {
+		filter["object_size_less_than"] = int(*lessThan)
+	}
+	if len(filter) == 0 {
+		return nil
+	}
+	return []map[string]interface{}{filter}
+}
+
+func lifecycleRulesGet(rules []*s3.LifecycleRule) []map[string]interface{} {
+	lifecycleRules := make([]map[string]interface{}, 0, len(rules))
+	for _, r := range rules {
+		rule := map[string]interface{}{
+			"rule_id": aws.StringValue(r.ID),
+			"status":  aws.StringValue(r.Status),
+		}
+		if filter := lifecycleRuleFilterGet(r.Filter); filter != nil {
+			rule["filter"] = filter
+		}
+		if len(r.Transitions) > 0 {
+			transitions := make([]map[string]interface{}, 0, len(r.Transitions))
+			for _, t := range r.Transitions {
+				transition := map[string]interface{}{
+					"storage_class": aws.StringValue(t.StorageClass),
+				}
+				if t.Date != nil {
+					transition["date"] = t.Date.UTC().Format("2006-01-02")
+				} else {
+					transition["days"] = int(aws.Int64Value(t.Days))
+				}
+				transitions = append(transitions, transition)
+			}
+			rule["transition"] = transitions
+		}
+		if r.Expiration != nil {
+			expiration := map[string]interface{}{}
+			if r.Expiration.Days != nil {
+				expiration["days"] = int(*r.Expiration.Days)
+			}
+			if r.Expiration.Date != nil {
+				expiration["date"] = r.Expiration.Date.UTC().Format("2006-01-02")
+			}
+			if r.Expiration.ExpiredObjectDeleteMarker != nil {
+				expiration["expired_object_delete_marker"] = *r.Expiration.ExpiredObjectDeleteMarker
+			}
+			rule["expiration"] = []map[string]interface{}{expiration}
+		}
+		if r.NoncurrentVersionExpiration != nil {
+			rule["noncurrent_version_expiration"] = []map[string]interface{}{{
+				"noncurrent_days": int(aws.Int64Value(r.NoncurrentVersionExpiration.NoncurrentDays)),
+			}}
+		}
+		if r.AbortIncompleteMultipartUpload != nil {
+			rule["abort_incomplete_multipart_upload"] = []map[string]interface{}{{
+				"days_after_initiation": int(aws.Int64Value(r.AbortIncompleteMultipartUpload.DaysAfterInitiation)),
+			}}
+		}
+		lifecycleRules = append(lifecycleRules, rule)
+	}
+	return lifecycleRules
+}
+
+func putBucketLifecycleConfiguration(d *schema.ResourceData, meta interface{}, bucketName, bucketLocation, endpointType, instanceCRN string) error {
+	rules := lifecycleRulesSet(d.Get("lifecycle_rule").([]interface{}))
+	bxSession, err := meta.(conns.ClientSession).BluemixSession()
+	if err != nil {
+		return err
+	}
+	s3Client, err := getS3ClientSession(bxSession, bucketLocation, endpointType, instanceCRN)
+	if err != nil {
+		return err
+	}
+	putBucketLifecycleInput := &s3.PutBucketLifecycleConfigurationInput{
+		Bucket: aws.String(bucketName),
+		LifecycleConfiguration: &s3.LifecycleConfiguration{
+			Rules: rules,
+		},
+	}
+	_, err = s3Client.PutBucketLifecycleConfiguration(putBucketLifecycleInput)
+	if err != nil {
+		return fmt.Errorf("failed to put the lifecycle configuration on the COS bucket %s, %v", bucketName, err)
+	}
+	return nil
+}
+
+// checkNoBucketLifecycleConfiguration fails when the bucket already has lifecycle rules. They are either managed by
+// the lifecycle arguments of ibm_cos_bucket, which would be overwritten on every apply, or should be imported.
+func checkNoBucketLifecycleConfiguration(meta interface{}, bucketName, bucketLocation, endpointType, instanceCRN string) error {
+	bxSession, err := meta.(conns.ClientSession).BluemixSession()
+	if err != nil {
+		return err
+	}
+	s3Client, err := getS3ClientSession(bxSession, bucketLocation, endpointType, instanceCRN)
+	if err != nil {
+		return err
+	}
+	output, err := s3Client.GetBucketLifecycleConfiguration(&s3.GetBucketLifecycleConfigurationInput{
+		Bucket: aws.String(bucketName),
+	})
+	if err != nil {
+		if strings.Contains(err.Error(), "NoSuchLifecycleConfiguration") {
+			return nil
+		}
+		return fmt.Errorf("failed to get the lifecycle configuration of the COS bucket %s, %v", bucketName, err)
+	}
+	if output != nil && len(output.Rules) > 0 {
+		return fmt.Errorf("the COS bucket %s already has %d lifecycle rules. Remove the archive_rule, expire_rule, noncurrent_version_expiration and abort_incomplete_multipart_upload_days arguments of the ibm_cos_bucket resource of this bucket, or import the lifecycle configuration", bucketName, len(output.Rules))
+	}
+	return nil
+}
+
+func resourceIBMCOSBucketLifecycleConfigurationCreate(d *schema.ResourceData, meta interface{}) error {
+	bucketCRN := d.Get("bucket_crn").(string)
+	bucketName := strings.Split(bucketCRN, ":bucket:")[1]
+	instanceCRN := fmt.Sprintf("%s::", strings.Split(bucketCRN, ":bucket:")[0])
+	bucketLocation := d.Get("bucket_location").(string)
+	endpointType := d.Get("endpoint_type").(string)
+	if err := checkNoBucketLifecycleConfiguration(meta, bucketName, bucketLocation, endpointType, instanceCRN); err != nil {
+		return err
+	}
+	err := putBucketLifecycleConfiguration(d, meta, bucketName, bucketLocation, endpointType, instanceCRN)
+	if err != nil {
+		return err
+	}
+	bktID := fmt.Sprintf("%s:%s:%s:meta:%s:%s", strings.Replace(instanceCRN, "::", "", -1), "bucket", bucketName, bucketLocation, endpointType)
+	d.SetId(bktID)
+	return resourceIBMCOSBucketLifecycleConfigurationRead(d, meta)
+}
+
+func resourceIBMCOSBucketLifecycleConfigurationUpdate(d *schema.ResourceData, meta interface{}) error {
+	bucketName := parseWebsiteId(d.Id(), "bucketName")
+	bucketLocation := parseWebsiteId(d.Id(), "bucketLocation")
+	instanceCRN := parseWebsiteId(d.Id(), "instanceCRN")
+	endpointType := d.Get("endpoint_type").(string)
+	if d.HasChange("lifecycle_rule") {
+		err := putBucketLifecycleConfiguration(d, meta, bucketName, bucketLocation, endpointType, instanceCRN)
+		if err != nil {
+			return err
+		}
+	}
+	if d.HasChange("endpoint_type") {
+		d.SetId(fmt.Sprintf("%s:meta:%s:%s", parseWebsiteId(d.Id(), "bucketCRN"), bucketLocation, endpointType))
+	}
+	return resourceIBMCOSBucketLifecycleConfigurationRead(d, meta)
+}
+
+func resourceIBMCOSBucketLifecycleConfigurationRead(d *schema.ResourceData, meta interface{}) error {
+	bucketCRN := parseWebsiteId(d.Id(), "bucketCRN")
+	bucketName := parseWebsiteId(d.Id(), "bucketName")
+	bucketLocation := parseWebsiteId(d.Id(), "bucketLocation")
+	instanceCRN := parseWebsiteId(d.Id(), "instanceCRN")
+	endpointType := parseWebsiteId(d.Id(), "endpointType")
+	d.Set("bucket_crn", bucketCRN)
+	d.Set("bucket_location", bucketLocation)
+	if endpointType != "" {
+		d.Set("endpoint_type", endpointType)
+	}
+	bxSession, err := meta.(conns.ClientSession).BluemixSession()
+	if err != nil {
+		return err
+	}
+	s3Client, err := getS3ClientSession(bxSession, bucketLocation, endpointType, instanceCRN)
+	if err != nil {
+		return err
+	}
+	getBucketLifecycleInput := &s3.GetBucketLifecycleConfigurationInput{
+		Bucket: aws.String(bucketName),
+	}
+	output, err := s3Client.GetBucketLifecycleConfiguration(getBucketLifecycleInput)
+	if err != nil {
+		if strings.Contains(err.Error(), "NoSuchLifecycleConfiguration") {
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("failed to get the lifecycle configuration of the COS bucket %s, %v", bucketName, err)
+	}
+	if output != nil {
+		d.Set("lifecycle_rule", lifecycleRulesGet(output.Rules))
+	}
+	return nil
+}
+
+func resourceIBMCOSBucketLifecycleConfigurationDelete(d *schema.ResourceData, meta interface{}) error {
+	bucketName := parseWebsiteId(d.Id(), "bucketName")
+	bucketLocation := parseWebsiteId(d.Id(), "bucketLocation")
+	instanceCRN := parseWebsiteId(d.Id(), "instanceCRN")
+	endpointType := parseWebsiteId(d.Id(), "endpointType")
+	bxSession, err := meta.(conns.ClientSession).BluemixSession()
+	if err != nil {
+		return err
+	}
+	s3Client, err := getS3ClientSession(bxSession, bucketLocation, endpointType, instanceCRN)
+	if err != nil {
+		return err
+	}
+	deleteBucketLifecycleInput := &s3.DeleteBucketLifecycleInput{
+		Bucket: aws.String(bucketName),
+	}
+	_, err = s3Client.DeleteBucketLifecycle(deleteBucketLifecycleInput)
+	if err != nil {
+		return fmt.Errorf("failed to delete the lifecycle configuration of the COS bucket %s, %v", bucketName, err)
+	}
+	return nil
+}
@@ -0,0 +1,161 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package cos
+
+import (
+	"testing"
+
+	"github.com/IBM/ibm-cos-sdk-go/aws"
+	"github.com/IBM/ibm-cos-sdk-go/service/s3"
+	"github.com/hashicorp/go-cty/cty"
+	"gotest.tools/assert"
+)
+
+func TestLifecycleRuleFilter(t *testing.T) {
+	testcases := []struct {
+		filter   map[string]interface{}
+		expected *s3.LifecycleRuleFilter
+	}{
+		{
+			filter:   map[string]interface{}{"prefix": "logs/"},
+			expected: &s3.LifecycleRuleFilter{Prefix: aws.String("logs/")},
+		},
+		{
+			filter: map[string]interface{}{"tag": []interface{}{map[string]interface{}{"key": "env", "value": "dev"}}},
+			expected: &s3.LifecycleRuleFilter{
+				Tag: &s3.Tag{Key: aws.String("env"), Value: aws.String("dev")},
+			},
+		},
+		{
+			filter:   map[string]interface{}{"object_size_greater_than": 1024},
+			expected: &s3.LifecycleRuleFilter{ObjectSizeGreaterThan: aws.Int64(1024)},
+		},
+		{
+			filter: map[string]interface{}{
+				"prefix":                   "logs/",
+				"tag":                      []interface{}{map[string]interface{}{"key": "env", "value": "dev"}, map[string]interface{}{"key": "team", "value": "a"}},
+				"object_size_greater_than": 1024,
+				"object_size_less_than":    4096,
+			},
+			expected: &s3.LifecycleRuleFilter{
+				And: &s3.LifecycleRuleAndOperator{
+					Prefix:                aws.String("logs/"),
+					Tags:                  []*s3.Tag{{Key: aws.String("env"), Value: aws.String("dev")}, {Key: aws.String("team"), Value: aws.String("a")}},
+					ObjectSizeGreaterThan: aws.Int64(1024),
+					ObjectSizeLessThan:    aws.Int64(4096),
+				},
+			},
+		},
+	}
+	for _, tc := range testcases {
+		filterMap := map[string]interface{}{"prefix": "", "tag": []interface{}{}, "object_size_greater_than": 0, "object_size_less_than": 0}
+		for k, v := range tc.filter {
+			filterMap[k] = v
+		}
+		filter := lifecycleRuleFilterSet(filterMap)
+		assert.DeepEqual(t, filter.String(), tc.expected.String())
+
+		read := lifecycleRuleFilterGet(filter)
+		assert.Equal(t, len(read), 1)
+		for k, v := range tc.filter {
+			if tags, ok := v.([]interface{}); ok {
+				assert.Equal(t, len(read[0]["tag"].([]map[string]interface{})), len(tags))
+				continue
+			}
+			assert.Equal(t, read[0][k], v)
+		}
+	}
+	assert.Assert(t, lifecycleRuleFilterGet(&s3.LifecycleRuleFilter{}) == nil)
+}
+
+func TestValidateLifecycleRules(t *testing.T) {
+	transition := func(days, date cty.Value) cty.Value {
+		return cty.ObjectVal(map[string]cty.Value{"days": days, "date": date, "storage_class": cty.StringVal("GLACIER")})
+	}
+	expiration := func(days, date, marker cty.Value) cty.Value {
+		return cty.ObjectVal(map[string]cty.Value{"days": days, "date": date, "expired_object_delete_marker": marker})
+	}
+	transitionType := transition(cty.NullVal(cty.Number), cty.NullVal(cty.String)).Type()
+	expirationType := expiration(cty.NullVal(cty.Number), cty.NullVal(cty.String), cty.NullVal(cty.Bool)).Type()
+	rule := func(transitions []cty.Value, expirations []cty.Value) cty.Value {
+		transitionList, expirationList := cty.ListValEmpty(transitionType), cty.ListValEmpty(expirationType)
+		if len(transitions) > 0 {
+			transitionList = cty.ListVal(transitions)
+		}
+		if len(expirations) > 0 {
+			expirationList = cty.ListVal(expirations)
+		}
+		return cty.ObjectVal(map[string]cty.Value{
+			"rule_id":                           cty.StringVal("rule"),
+			"transition":                        transitionList,
+			"expiration":                        expirationList,
+			"noncurrent_version_expiration":     cty.ListValEmpty(cty.EmptyObject),
+			"abort_incomplete_multipart_upload": cty.ListValEmpty(cty.EmptyObject),
+		})
+	}
+	null := map[cty.Type]cty.Value{cty.Number: cty.NullVal(cty.Number), cty.String: cty.NullVal(cty.String), cty.Bool: cty.NullVal(cty.Bool)}
+
+	testcases := []struct {
+		name          string
+		rule          cty.Value
+		expectedError string
+	}{
+		{
+			name: "transitions with days and date",
+			rule: rule([]cty.Value{
+				transition(cty.NumberIntVal(0), null[cty.String]),
+				transition(null[cty.Number], cty.StringVal("2030-01-01")),
+			}, nil),
+		},
+		{
+			name:          "transition with days and date",
+			rule:          rule([]cty.Value{transition(cty.NumberIntVal(30), cty.StringVal("2030-01-01"))}, nil),
+			expectedError: "lifecycle rule rule: exactly one of days, date must be set in transition",
+		},
+		{
+			name: "second transition without days or date",
+			rule: rule([]cty.Value{
+				transition(cty.NumberIntVal(30), null[cty.String]),
+				transition(null[cty.Number], null[cty.String]),
+			}, nil),
+			expectedError: "lifecycle rule rule: exactly one of days, date must be set in transition",
+		},
+		{
+			name: "transition with unknown days",
+			rule: rule([]cty.Value{transition(cty.UnknownVal(cty.Number), null[cty.String])}, nil),
+		},
+		{
+			name: "expiration with days",
+			rule: rule(nil, []cty.Value{expiration(cty.NumberIntVal(7), null[cty.String], cty.False)}),
+		},
+		{
+			name: "expiration with delete marker",
+			rule: rule(nil, []cty.Value{expiration(null[cty.Number], null[cty.String], cty.True)}),
+		},
+		{
+			name:          "expiration with days and delete marker",
+			rule:          rule(nil, []cty.Value{expiration(cty.NumberIntVal(7), null[cty.String], cty.True)}),
+			expectedError: "lifecycle rule rule: exactly one of days, date, expired_object_delete_marker must be set in expiration",
+		},
+		{
+			name:          "empty expiration",
+			rule:          rule(nil, []cty.Value{expiration(null[cty.Number], null[cty.String], cty.False)}),
+			expectedError: "lifecycle rule rule: exactly one of days, date, expired_object_delete_marker must be set in expiration",
+		},
+		{
+			name:          "no action",
+			rule:          rule(nil, nil),
+			expectedError: "lifecycle rule rule: at least one of transition, expiration, noncurrent_version_expiration or abort_incomplete_multipart_upload must be set",
+		},
+	}
+	for _, tc := range testcases {
+		err := validateLifecycleRules(cty.ListVal([]cty.Value{tc.rule}))
+		if tc.expectedError == "" {
+			assert.NilError(t, err, tc.name)
+			continue
+		}
+		assert.Error(t, err, tc.expectedError, tc.name)
+	}
+	assert.NilError(t, validateLifecycleRules(cty.UnknownVal(cty.List(cty.DynamicPseudoType))))
+}
@@ -0,0 +1,142 @@
+package cos_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIBMCosBucket_Lifecycle_Configuration_Basic(t *testing.T) {
+	serviceName := fmt.Sprintf("terraform_%d", acctest.RandIntRange(10, 100))
+	bucketName := fmt.Sprintf("terraform-lifecycle%d", acctest.RandIntRange(10, 100))
+	bucketRegion := "us-south"
+	bucketClass := "standard"
+	bucketRegionType := "region_location"
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckIBMCosBucketDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMCosBucket_Lifecycle_Configuration_Basic(serviceName, bucketName, bucketRegion, bucketClass, 30),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckIBMCosBucketExists("ibm_resource_instance.instance", "ibm_cos_bucket.bucket", bucketRegionType, bucketRegion, bucketName),
+					resource.TestCheckResourceAttr("ibm_cos_bucket_lifecycle_configuration.lifecycle", "lifecycle_rule.#", "3"),
+					resource.TestCheckResourceAttr("ibm_cos_bucket_lifecycle_configuration.lifecycle", "lifecycle_rule.0.rule_id", "archive-logs"),
+					resource.TestCheckResourceAttr("ibm_cos_bucket_lifecycle_configuration.lifecycle", "lifecycle_rule.0.filter.0.prefix", "logs/"),
+					resource.TestCheckResourceAttr("ibm_cos_bucket_lifecycle_configuration.lifecycle", "lifecycle_rule.0.transition.0.days", "30"),
+					resource.TestCheckResourceAttr("ibm_cos_bucket_lifecycle_configuration.lifecycle", "lifecycle_rule.1.expiration.0.days", "90"),
+					resource.TestCheckResourceAttr("ibm_cos_bucket_lifecycle_configuration.lifecycle", "lifecycle_rule.1.filter.0.tag.0.key", "env"),
+					resource.TestCheckResourceAttr("ibm_cos_bucket_lifecycle_configuration.lifecycle", "lifecycle_rule.1.filter.0.object_size_greater_than", "1024"),
+					resource.TestCheckResourceAttr("ibm_cos_bucket_lifecycle_configuration.lifecycle", "lifecycle_rule.2.abort_incomplete_multipart_upload.0.days_after_initiation", "2"),
+				),
+			},
+			{
+				Config: testAccCheckIBMCosBucket_Lifecycle_Configuration_Basic(serviceName, bucketName, bucketRegion, bucketClass, 60),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_cos_bucket_lifecycle_configuration.lifecycle", "lifecycle_rule.0.transition.0.days", "60"),
+				),
+			},
+			{
+				ResourceName:      "ibm_cos_bucket_lifecycle_configuration.lifecycle",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccIBMCosBucket_Lifecycle_Configuration_TransitionDaysAndDate(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				resource "ibm_cos_bucket_lifecycle_configuration" "lifecycle" {
+					bucket_crn      = "crn:v1:bluemix:public:cloud-object-storage:global:a/1234:5678:bucket:my-bucket"
+					bucket_location = "us-south"
+					lifecycle_rule {
+						rule_id = "archive"
+						status  = "Enabled"
+						transition {
+							days          = 30
+							storage_class = "ACCELERATED"
+						}
+						transition {
+							days          = 90
+							date          = "2030-01-01"
+							storage_class = "GLACIER"
+						}
+					}
+				}`,
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("exactly one of days, date must be set in transition"),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMCosBucket_Lifecycle_Configuration_Basic(cosServiceName string, bucketName string, region string, storageClass string, archiveDays int) string {
+
+	return fmt.Sprintf(`
+	data "ibm_resource_group" "cos_group" {
+		name = "Default"
+	}
+
+	resource "ibm_resource_instance" "instance" {
+		name              = "%s"
+		service           = "cloud-object-storage"
+		plan              = "standard"
+		location          = "global"
+		resource_group_id = data.ibm_resource_group.cos_group.id
+	}
+	resource "ibm_cos_bucket" "bucket" {
+		bucket_name          = "%s"
+		resource_instance_id = ibm_resource_instance.instance.id
+		region_location      = "%s"
+		storage_class        = "%s"
+	}
+	resource "ibm_cos_bucket_lifecycle_configuration" "lifecycle" {
+		bucket_crn      = ibm_cos_bucket.bucket.crn
+		bucket_location = ibm_cos_bucket.bucket.region_location
+		lifecycle_rule {
+			rule_id = "archive-logs"
+			status  = "Enabled"
+			filter {
+				prefix = "logs/"
+			}
+			transition {
+				days          = %d
+				storage_class = "GLACIER"
+			}
+		}
+		lifecycle_rule {
+			rule_id = "expire-tmp"
+			status  = "Enabled"
+			filter {
+				prefix = "tmp/"
+				tag {
+					key   = "env"
+					value = "dev"
+				}
+				object_size_greater_than = 1024
+			}
+			expiration {
+				days = 90
+			}
+		}
+		lifecycle_rule {
+			rule_id = "abort-uploads"
+			status  = "Enabled"
+			abort_incomplete_multipart_upload {
+				days_after_initiation = 2
+			}
+		}
+	}
+	`, cosServiceName, bucketName, region, storageClass, archiveDays)
+}
@@ -0,0 +1,658 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package cos
+
+import (
+	"context"
+	"crypto/md5"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"io/fs"
+	"log"
+	"mime"
+	"net/http"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	"github.com/IBM/ibm-cos-sdk-go/aws"
+	"github.com/IBM/ibm-cos-sdk-go/service/s3"
+	"github.com/IBM/ibm-cos-sdk-go/service/s3/s3manager"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	validation "github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// cosDeleteObjectsBatchSize is the maximum number of keys that can be deleted with a single DeleteObjects request.
+const cosDeleteObjectsBatchSize = 1000
+
+func ResourceIBMCOSBucketObjectsSync() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIBMCOSBucketObjectsSyncCreate,
+		ReadContext:   resourceIBMCOSBucketObjectsSyncRead,
+		UpdateContext: resourceIBMCOSBucketObjectsSyncUpdate,
+		DeleteContext: resourceIBMCOSBucketObjectsSyncDelete,
+		CustomizeDiff: resourceIBMCOSBucketObjectsSyncDiff,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+			Update: schema.DefaultTimeout(60 * time.Minute),
+			Delete: schema.DefaultTimeout(20 * time.Minute),
+		},
+		Schema: map[string]*schema.Schema{
+			"bucket_crn": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "COS bucket CRN",
+			},
+			"bucket_location": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "COS bucket location",
+			},
+			"endpoint_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validate.ValidateAllowedStringValues([]string{"public", "private", "direct"}),
+				Description:  "COS endpoint type: public, private, direct",
+				Default:      "public",
+			},
+			"source_dir": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Path of the local directory that is mirrored into the bucket",
+			},
+			"key_prefix": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Default:     "",
+				Description: "Prefix that is prepended to the relative path of every file to form the object key",
+			},
+			"include": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Glob patterns of the files to upload, matched against the path relative to source_dir. All files are uploaded if not set",
+			},
+			"exclude": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Glob patterns of the files to skip, matched against the path relative to source_dir",
+			},
+			"content_type_overrides": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Content types by file extension, for example .wasm = application/wasm, that take precedence over the detected content type",
+			},
+			"multipart_chunk_size": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      15,
+				ValidateFunc: validation.IntBetween(5, 5120),
+				Description:  "Size in MiB of the parts of a multipart upload. Files larger than this size are uploaded in parts",
+			},
+			"concurrency": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      10,
+				ValidateFunc: validation.IntBetween(1, 100),
+				Description:  "Number of files that are uploaded in parallel",
+			},
+			"delete_removed": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "Delete objects from the bucket when their source files are removed from source_dir",
+			},
+			"source_hash": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Hash of the keys, MD5 digests and content types of all synchronized objects",
+			},
+			"objects": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The objects that are synchronized into the bucket",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"key": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "COS object key",
+						},
+						"source": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Path of the source file relative to source_dir",
+						},
+						"md5": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "MD5 hexdigest of the source file",
+						},
+						"etag": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "COS object ETag. For multipart uploads this is not the MD5 digest of the object",
+						},
+						"content_type": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "COS object content type",
+						},
+						"size": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "Size of the source file in bytes",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// cosSyncFile is a file of the source directory that is synchronized into the bucket.
+type cosSyncFile struct {
+	key         string
+	source      string
+	path        string
+	md5         string
+	contentType string
+	size        int64
+}
+
+// globToRegexp converts a glob pattern to a regular expression. `*` and `?` do not match the path separator,
+// `**` matches any number of directories.
+func globToRegexp(pattern string) *regexp.Regexp {
+	var sb strings.Builder
+	sb.WriteString("^")
+	for i := 0; i < len(pattern); i++ {
+		switch c := pattern[i]; c {
+		case '*':
+			if i+1 < len(pattern) && pattern[i+1] == '*' {
+				i++
+				if i+1 < len(pattern) && pattern[i+1] == '/' {
+					i++
+					sb.WriteString("(.*/)?")
+				} else {
+					sb.WriteString(".*")
+				}
+			} else {
+				sb.WriteString("[^/]*")
+			}
+		case '?':
+			sb.WriteString("[^/]")
+		default:
+			sb.WriteString(regexp.QuoteMeta(string(c)))
+		}
+	}
+	sb.WriteString("$")
+	return regexp.MustCompile(sb.String())
+}
+
+func matchesAnyGlob(patterns []*regexp.Regexp, path string) bool {
+	for _, p := range patterns {
+		if p.MatchString(path) {
+			return true
+		}
+	}
+	return false
+}
+
+func detectCOSContentType(path string, overrides map[string]interface{}) (string, error) {
+	ext := strings.ToLower(filepath.Ext(path))
+	if v, ok := overrides[ext]; ok {
+		return v.(string), nil
+	}
+	if contentType := mime.TypeByExtension(ext); contentType != "" {
+		return contentType, nil
+	}
+	file, err := os.Open(path)
+	if err != nil {
+		return "", err
+	}
+	defer file.Close()
+	buf := make([]byte, 512)
+	n, err := io.ReadFull(file, buf)
+	if err != nil && err != io.EOF && err != io.ErrUnexpectedEOF {
+		return "", err
+	}
+	return http.DetectContentType(buf[:n]), nil
+}
+
+func fileMD5(path string) (string, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return "", err
+	}
+	defer file.Close()
+	hash := md5.New()
+	if _, err := io.Copy(hash, file); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(hash.Sum(nil)), nil
+}
+
+// scanCOSSyncSourceDir returns the files of the source directory that match the include and exclude patterns,
+// sorted by object key.
+func scanCOSSyncSourceDir(sourceDir, keyPrefix string, include, exclude []string, overrides map[string]interface{}) ([]cosSyncFile, error) {
+	includePatterns := make([]*regexp.Regexp, 0, len(include))
+	for _, p := range include {
+		includePatterns = append(includePatterns, globToRegexp(p))
+	}
+	excludePatterns := make([]*regexp.Regexp, 0, len(exclude))
+	for _, p := range exclude {
+		excludePatterns = append(excludePatterns, globToRegexp(p))
+	}
+
+	files := make([]cosSyncFile, 0)
+	err := filepath.WalkDir(sourceDir, func(path string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if !entry.Type().IsRegular() {
+			return nil
+		}
+		rel, err := filepath.Rel(sourceDir, path)
+		if err != nil {
+			return err
+		}
+		rel = filepath.ToSlash(rel)
+		if len(includePatterns) > 0 && !matchesAnyGlob(includePatterns, rel) {
+			return nil
+		}
+		if matchesAnyGlob(excludePatterns, rel) {
+			return nil
+		}
+		info, err := entry.Info()
+		if err != nil {
+			return err
+		}
+		digest, err := fileMD5(path)
+		if err != nil {
+			return err
+		}
+		contentType, err := detectCOSContentType(path, overrides)
+		if err != nil {
+			return err
+		}
+		files = append(files, cosSyncFile{
+			key:         keyPrefix + rel,
+			source:      rel,
+			path:        path,
+			md5:         digest,
+			contentType: contentType,
+			size:        info.Size(),
+		})
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("[ERROR] Error reading source directory (%s): %s", sourceDir, err)
+	}
+	sort.Slice(files, func(i, j int) bool {
+		return files[i].key < files[j].key
+	})
+	return files, nil
+}
+
+// cosSyncSourceHash hashes the object keys and MD5 digests, so that any added, removed or modified file changes it.
+func cosSyncSourceHash(digests map[string]string) string {
+	keys := make([]string, 0, len(digests))
+	for k := range digests {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	hash := sha256.New()
+	for _, k := range keys {
+		fmt.Fprintf(hash, "%s\x00%s\n", k, digests[k])
+	}
+	return hex.EncodeToString(hash.Sum(nil))
+}
+
+func scanCOSSyncSourceDirFromConfig(d interface {
+	Get(string) interface{}
+}) ([]cosSyncFile, error) {
+	return scanCOSSyncSourceDir(
+		d.Get("source_dir").(string),
+		d.Get("key_prefix").(string),
+		flex.ExpandStringList(d.Get("include").([]interface{})),
+		flex.ExpandStringList(d.Get("exclude").([]interface{})),
+		d.Get("content_type_overrides").(map[string]interface{}),
+	)
+}
+
+func resourceIBMCOSBucketObjectsSyncDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	for _, k := range []string{"source_dir", "key_prefix", "include", "exclude", "content_type_overrides"} {
+		if !d.NewValueKnown(k) {
+			d.SetNewComputed("source_hash")
+			d.SetNewComputed("objects")
+			return nil
+		}
+	}
+	files, err := scanCOSSyncSourceDirFromConfig(d)
+	if err != nil {
+		return err
+	}
+	digests := make(map[string]string, len(files))
+	for _, f := range files {
+		digests[f.key] = f.md5 + ":" + f.contentType
+	}
+	if hash := cosSyncSourceHash(digests); hash != d.Get("source_hash").(string) {
+		d.SetNew("source_hash", hash)
+		d.SetNewComputed("objects")
+	}
+	return nil
+}
+
+func resourceIBMCOSBucketObjectsSyncCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	bucketCRN := d.Get("bucket_crn").(string)
+	bucketLocation := d.Get("bucket_location").(string)
+	keyPrefix := d.Get("key_prefix").(string)
+	d.SetId(fmt.Sprintf("%s:sync:%s:location:%s", bucketCRN, keyPrefix, bucketLocation))
+	return resourceIBMCOSBucketObjectsSyncUpdate(ctx, d, m)
+}
+
+func resourceIBMCOSBucketObjectsSyncUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	bucketCRN := d.Get("bucket_crn").(string)
+	bucketName := strings.Split(bucketCRN, ":bucket:")[1]
+	instanceCRN := fmt.Sprintf("%s::", strings.Split(bucketCRN, ":bucket:")[0])
+	bucketLocation := d.Get("bucket_location").(string)
+	endpointType := d.Get("endpoint_type").(string)
+
+	bxSession, err := m.(conns.ClientSession).BluemixSession()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	s3Client, err := getS3Client(bxSession, bucketLocation, endpointType, instanceCRN)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	files, err := scanCOSSyncSourceDirFromConfig(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	current := make(map[string]map[string]interface{})
+	for _, o := range d.Get("objects").([]interface{}) {
+		object := o.(map[string]interface{})
+		current[object["key"].(string)] = object
+	}
+
+	toUpload := make([]cosSyncFile, 0)
+	for _, f := range files {
+		if object, ok := current[f.key]; !ok || object["md5"].(string) != f.md5 || object["content_type"].(string) != f.contentType {
+			toUpload = append(toUpload, f)
+		}
+	}
+
+	uploader := s3manager.NewUploaderWithClient(s3Client, func(u *s3manager.Uploader) {
+		u.PartSize = int64(d.Get("multipart_chunk_size").(int)) * 1024 * 1024
+	})
+	etags, uploadErrs := uploadCOSSyncFiles(ctx, uploader, bucketName, toUpload, d.Get("concurrency").(int))
+
+	local := make(map[string]bool, len(files))
+	for _, f := range files {
+		local[f.key] = true
+	}
+	toDelete := make([]string, 0)
+	if d.Get("delete_removed").(bool) {
+		for key := range current {
+			if !local[key] {
+				toDelete = append(toDelete, key)
+			}
+		}
+		sort.Strings(toDelete)
+	}
+	deleted, deleteErr := deleteCOSObjects(ctx, s3Client, bucketName, toDelete)
+
+	objects, digests := cosSyncedObjects(files, current, etags, toDelete, deleted)
+	d.Set("objects", objects)
+	d.Set("source_hash", cosSyncSourceHash(digests))
+
+	if len(uploadErrs) > 0 {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error uploading %d of %d objects to COS bucket (%s): %s", len(uploadErrs), len(toUpload), bucketName, strings.Join(uploadErrs, "; ")))
+	}
+	if deleteErr != nil {
+		return diag.FromErr(deleteErr)
+	}
+	log.Printf("[DEBUG] Uploaded %d and deleted %d objects of COS bucket (%s)", len(toUpload), len(toDelete), bucketName)
+	return resourceIBMCOSBucketObjectsSyncRead(ctx, d, m)
+}
+
+// cosSyncedObjects returns the objects that were actually synchronized and their digests for the source hash, so that
+// a partial failure is retried on the next apply: a file whose upload failed keeps its previous object, if any, but
+// its digest is left out of the source hash, and an object whose deletion failed is kept and changes the source hash.
+func cosSyncedObjects(files []cosSyncFile, current map[string]map[string]interface{}, etags map[string]string, toDelete []string, deleted map[string]bool) ([]map[string]interface{}, map[string]string) {
+	objects := make([]map[string]interface{}, 0, len(files))
+	digests := make(map[string]string, len(files))
+	for _, f := range files {
+		if etag, ok := etags[f.key]; ok {
+			objects = append(objects, map[string]interface{}{
+				"key":          f.key,
+				"source":       f.source,
+				"md5":          f.md5,
+				"etag":         etag,
+				"content_type": f.contentType,
+				"size":         int(f.size),
+			})
+			digests[f.key] = f.md5 + ":" + f.contentType
+		} else if object, ok := current[f.key]; ok {
+			objects = append(objects, object)
+			if object["md5"].(string) == f.md5 && object["content_type"].(string) == f.contentType {
+				digests[f.key] = f.md5 + ":" + f.contentType
+			}
+		}
+	}
+	for _, key := range toDelete {
+		if !deleted[key] {
+			objects = append(objects, current[key])
+			digests[key] = ""
+		}
+	}
+	sort.Slice(objects, func(i, j int) bool {
+		return objects[i]["key"].(string) < objects[j]["key"].(string)
+	})
+	return objects, digests
+}
+
+// uploadCOSSyncFiles uploads the files with the given concurrency and returns the ETags of the uploaded objects
+// together with the errors of the failed uploads.
+func uploadCOSSyncFiles(ctx context.Context, uploader *s3manager.Uploader, bucketName string, files []cosSyncFile, concurrency int) (map[string]string, []string) {
+	etags := make(map[string]string, len(files))
+	errs := make([]string, 0)
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+	work := make(chan cosSyncFile)
+
+	for i := 0; i < concurrency; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for f := range work {
+				etag, err := uploadCOSSyncFile(ctx, uploader, bucketName, f)
+				mu.Lock()
+				if err != nil {
+					errs = append(errs, err.Error())
+				} else {
+					etags[f.key] = etag
+				}
+				mu.Unlock()
+			}
+		}()
+	}
+	for _, f := range files {
+		work <- f
+	}
+	close(work)
+	wg.Wait()
+	sort.Strings(errs)
+	return etags, errs
+}
+
+func uploadCOSSyncFile(ctx context.Context, uploader *s3manager.Uploader, bucketName string, f cosSyncFile) (string, error) {
+	file, err := os.Open(f.path)
+	if err != nil {
+		return "", fmt.Errorf("%s: %s", f.source, err)
+	}
+	defer func() {
+		err := file.Close()
+		if err != nil {
+			log.Printf("[WARN] Failed closing COS object file (%s): %s", f.path, err)
+		}
+	}()
+	out, err := uploader.UploadWithContext(ctx, &s3manager.UploadInput{
+		Bucket:      aws.String(bucketName),
+		Key:         aws.String(f.key),
+		Body:        file,
+		ContentType: aws.String(f.contentType),
+	})
+	if err != nil {
+		return "", fmt.Errorf("%s: %s", f.key, err)
+	}
+	if out.ETag == nil {
+		head, err := uploader.S3.HeadObjectWithContext(ctx, &s3.HeadObjectInput{
+			Bucket: aws.String(bucketName),
+			Key:    aws.String(f.key),
+		})
+		if err != nil {
+			return "", fmt.Errorf("%s: %s", f.key, err)
+		}
+		out.ETag = head.ETag
+	}
+	return strings.Trim(aws.StringValue(out.ETag), `"`), nil
+}
+
+// deleteCOSObjects deletes the keys in batches and returns the keys that were deleted.
+func deleteCOSObjects(ctx context.Context, s3Client *s3.S3, bucketName string, keys []string) (map[string]bool, error) {
+	deleted := make(map[string]bool, len(keys))
+	for start := 0; start < len(keys); start += cosDeleteObjectsBatchSize {
+		end := start + cosDeleteObjectsBatchSize
+		if end > len(keys) {
+			end = len(keys)
+		}
+		objects := make([]*s3.ObjectIdentifier, 0, end-start)
+		for _, key := range keys[start:end] {
+			objects = append(objects, &s3.ObjectIdentifier{Key: aws.String(key)})
+		}
+		out, err := s3Client.DeleteObjectsWithContext(ctx, &s3.DeleteObjectsInput{
+			Bucket: aws.String(bucketName),
+			Delete: &s3.Delete{
+				Objects: objects,
+				Quiet:   aws.Bool(true),
+			},
+		})
+		if err != nil {
+			return deleted, fmt.Errorf("[ERROR] Error deleting objects from COS bucket (%s): %s", bucketName, err)
+		}
+		failed := make(map[string]bool, len(out.Errors))
+		for _, e := range out.Errors {
+			failed[aws.StringValue(e.Key)] = true
+		}
+		for _, key := range keys[start:end] {
+			if !failed[key] {
+				deleted[key] = true
+			}
+		}
+		if len(out.Errors) > 0 {
+			return deleted, fmt.Errorf("[ERROR] Error deleting object (%s) from COS bucket (%s): %s", aws.StringValue(out.Errors[0].Key), bucketName, aws.StringValue(out.Errors[0].Message))
+		}
+	}
+	return deleted, nil
+}
+
+func resourceIBMCOSBucketObjectsSyncRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	bucketCRN := d.Get("bucket_crn").(string)
+	bucketName := strings.Split(bucketCRN, ":bucket:")[1]
+	instanceCRN := fmt.Sprintf("%s::", strings.Split(bucketCRN, ":bucket:")[0])
+	bucketLocation := d.Get("bucket_location").(string)
+	endpointType := d.Get("endpoint_type").(string)
+
+	bxSession, err := m.(conns.ClientSession).BluemixSession()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	s3Client, err := getS3Client(bxSession, bucketLocation, endpointType, instanceCRN)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	remote := make(map[string]string)
+	err = s3Client.ListObjectsV2PagesWithContext(ctx, &s3.ListObjectsV2Input{
+		Bucket: aws.String(bucketName),
+		Prefix: aws.String(d.Get("key_prefix").(string)),
+	}, func(page *s3.ListObjectsV2Output, lastPage bool) bool {
+		for _, object := range page.Contents {
+			remote[aws.StringValue(object.Key)] = strings.Trim(aws.StringValue(object.ETag), `"`)
+		}
+		return true
+	})
+	if err != nil {
+		if strings.Contains(err.Error(), "NoSuchBucket") {
+			log.Printf("[WARN] COS bucket (%s) not found, removing objects sync (%s) from state", bucketName, d.Id())
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(fmt.Errorf("[ERROR] Error listing objects of COS bucket (%s): %s", bucketName, err))
+	}
+
+	// Objects that were deleted or overwritten outside of Terraform are dropped, so that they are uploaded again.
+	tracked := d.Get("objects").([]interface{})
+	objects := make([]interface{}, 0, len(tracked))
+	digests := make(map[string]string)
+	for _, o := range tracked {
+		object := o.(map[string]interface{})
+		key := object["key"].(string)
+		if etag, ok := remote[key]; ok && etag == object["etag"].(string) {
+			objects = append(objects, object)
+			digests[key] = object["md5"].(string) + ":" + object["content_type"].(string)
+		}
+	}
+	d.Set("objects", objects)
+	if len(objects) != len(tracked) || d.Get("source_hash").(string) == "" {
+		d.Set("source_hash", cosSyncSourceHash(digests))
+	}
+	return nil
+}
+
+func resourceIBMCOSBucketObjectsSyncDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	bucketCRN := d.Get("bucket_crn").(string)
+	bucketName := strings.Split(bucketCRN, ":bucket:")[1]
+	instanceCRN := fmt.Sprintf("%s::", strings.Split(bucketCRN, ":bucket:")[0])
+	bucketLocation := d.Get("bucket_location").(string)
+	endpointType := d.Get("endpoint_type").(string)
+
+	bxSession, err := m.(conns.ClientSession).BluemixSession()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	s3Client, err := getS3Client(bxSession, bucketLocation, endpointType, instanceCRN)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	keys := make([]string, 0)
+	for _, o := range d.Get("objects").([]interface{}) {
+		keys = append(keys, o.(map[string]interface{})["key"].(string))
+	}
+	if _, err := deleteCOSObjects(ctx, s3Client, bucketName, keys); err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
@@ -0,0 +1,200 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package cos
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"gotest.tools/assert"
+)
+
+func TestGlobToRegexp(t *testing.T) {
+	testcases := []struct {
+		pattern string
+		matches map[string]bool
+	}{
+		{
+			pattern: "*.html",
+			matches: map[string]bool{"index.html": true, "docs/index.html": false, "index.htm": false, ".html": true},
+		},
+		{
+			pattern: "**/*.html",
+			matches: map[string]bool{"index.html": true, "docs/index.html": true, "docs/api/v1/index.html": true, "index.css": false},
+		},
+		{
+			pattern: "docs/**",
+			matches: map[string]bool{"docs/index.html": true, "docs/api/index.html": true, "docs": false, "src/docs/index.html": false},
+		},
+		{
+			pattern: "img/?.png",
+			matches: map[string]bool{"img/a.png": true, "img/ab.png": false, "img//.png": false, "img/.png": false},
+		},
+		{
+			pattern: "a+b[1].txt",
+			matches: map[string]bool{"a+b[1].txt": true, "aab1.txt": false},
+		},
+	}
+	for _, tc := range testcases {
+		re := globToRegexp(tc.pattern)
+		for path, expected := range tc.matches {
+			assert.Equal(t, re.MatchString(path), expected, "pattern %q, path %q", tc.pattern, path)
+		}
+	}
+}
+
+func TestScanCOSSyncSourceDir(t *testing.T) {
+	dir := t.TempDir()
+	for path, content := range map[string]string{
+		"index.html":        "<html></html>",
+		"app.js":            "console.log(1)",
+		"docs/guide.html":   "<html>guide</html>",
+		"docs/draft.md":     "draft",
+		"docs/tmp/cache.js": "cache",
+		".git/config":       "[core]",
+	} {
+		path = filepath.Join(dir, filepath.FromSlash(path))
+		assert.NilError(t, os.MkdirAll(filepath.Dir(path), 0o755))
+		assert.NilError(t, os.WriteFile(path, []byte(content), 0o644))
+	}
+	testcases := []struct {
+		include  []string
+		exclude  []string
+		expected []string
+	}{
+		{
+			expected: []string{"site/.git/config", "site/app.js", "site/docs/draft.md", "site/docs/guide.html", "site/docs/tmp/cache.js", "site/index.html"},
+		},
+		{
+			exclude:  []string{".git/**", "**/tmp/**"},
+			expected: []string{"site/app.js", "site/docs/draft.md", "site/docs/guide.html", "site/index.html"},
+		},
+		{
+			include:  []string{"**/*.html", "**/*.js"},
+			exclude:  []string{"docs/tmp/*"},
+			expected: []string{"site/app.js", "site/docs/guide.html", "site/index.html"},
+		},
+		{
+			include:  []string{"*.html"},
+			expected: []string{"site/index.html"},
+		},
+		{
+			include:  []string{"**"},
+			exclude:  []string{"**"},
+			expected: []string{},
+		},
+	}
+	for _, tc := range testcases {
+		files, err := scanCOSSyncSourceDir(dir, "site/", tc.include, tc.exclude, map[string]interface{}{".md": "text/markdown"})
+		assert.NilError(t, err)
+		keys := make([]string, 0, len(files))
+		for _, f := range files {
+			keys = append(keys, f.key)
+			if f.source == "docs/draft.md" {
+				assert.Equal(t, f.contentType, "text/markdown")
+			}
+		}
+		assert.DeepEqual(t, keys, tc.expected)
+	}
+}
+
+func TestCOSSyncSourceHash(t *testing.T) {
+	digests := map[string]string{"a.html": "1:text/html", "b.js": "2:text/javascript"}
+	hash := cosSyncSourceHash(digests)
+	testcases := []struct {
+		digests map[string]string
+		same    bool
+	}{
+		{digests: map[string]string{"b.js": "2:text/javascript", "a.html": "1:text/html"}, same: true},
+		{digests: map[string]string{"a.html": "1:text/html"}},
+		{digests: map[string]string{"a.html": "1:text/html", "b.js": "3:text/javascript"}},
+		{digests: map[string]string{"a.html": "1:text/plain", "b.js": "2:text/javascript"}},
+		{digests: map[string]string{"a.html": "1:text/html", "c.js": "2:text/javascript"}},
+		{digests: map[string]string{"a.html": "1:text/html", "b.js": "2:text/javascript", "c.js": ""}},
+	}
+	for _, tc := range testcases {
+		assert.Equal(t, cosSyncSourceHash(tc.digests) == hash, tc.same, "digests %v", tc.digests)
+	}
+	assert.Equal(t, cosSyncSourceHash(map[string]string{}), cosSyncSourceHash(nil))
+}
+
+func TestCOSSyncedObjects(t *testing.T) {
+	object := func(key, md5, etag string) map[string]interface{} {
+		return map[string]interface{}{"key": key, "source": key, "md5": md5, "etag": etag, "content_type": "text/html", "size": 1}
+	}
+	file := func(key, md5 string) cosSyncFile {
+		return cosSyncFile{key: key, source: key, md5: md5, contentType: "text/html", size: 1}
+	}
+	current := map[string]map[string]interface{}{
+		"kept.html":     object("kept.html", "1", "e1"),
+		"modified.html": object("modified.html", "2", "e2"),
+		"removed.html":  object("removed.html", "3", "e3"),
+	}
+	files := []cosSyncFile{file("added.html", "4"), file("kept.html", "1"), file("modified.html", "5")}
+	synced := map[string]string{"added.html": "4:text/html", "kept.html": "1:text/html", "modified.html": "5:text/html"}
+
+	testcases := []struct {
+		name         string
+		etags        map[string]string
+		toDelete     []string
+		deleted      map[string]bool
+		expectedKeys []string
+		expectedETag map[string]string
+		synchronized bool
+	}{
+		{
+			name:         "all synchronized",
+			etags:        map[string]string{"added.html": "e4", "modified.html": "e5"},
+			toDelete:     []string{"removed.html"},
+			deleted:      map[string]bool{"removed.html": true},
+			expectedKeys: []string{"added.html", "kept.html", "modified.html"},
+			expectedETag: map[string]string{"added.html": "e4", "kept.html": "e1", "modified.html": "e5"},
+			synchronized: true,
+		},
+		{
+			name:         "removed file not deleted",
+			etags:        map[string]string{"added.html": "e4", "modified.html": "e5"},
+			expectedKeys: []string{"added.html", "kept.html", "modified.html"},
+			expectedETag: map[string]string{"added.html": "e4", "kept.html": "e1", "modified.html": "e5"},
+			synchronized: true,
+		},
+		{
+			name:         "added file failed",
+			etags:        map[string]string{"modified.html": "e5"},
+			toDelete:     []string{"removed.html"},
+			deleted:      map[string]bool{"removed.html": true},
+			expectedKeys: []string{"kept.html", "modified.html"},
+			expectedETag: map[string]string{"kept.html": "e1", "modified.html": "e5"},
+		},
+		{
+			name:         "modified file failed",
+			etags:        map[string]string{"added.html": "e4"},
+			toDelete:     []string{"removed.html"},
+			deleted:      map[string]bool{"removed.html": true},
+			expectedKeys: []string{"added.html", "kept.html", "modified.html"},
+			expectedETag: map[string]string{"added.html": "e4", "kept.html": "e1", "modified.html": "e2"},
+		},
+		{
+			name:         "delete failed",
+			etags:        map[string]string{"added.html": "e4", "modified.html": "e5"},
+			toDelete:     []string{"removed.html"},
+			deleted:      map[string]bool{},
+			expectedKeys: []string{"added.html", "kept.html", "modified.html", "removed.html"},
+			expectedETag: map[string]string{"added.html": "e4", "kept.html": "e1", "modified.html": "e5", "removed.html": "e3"},
+		},
+	}
+	for _, tc := range testcases {
+		objects, digests := cosSyncedObjects(files, current, tc.etags, tc.toDelete, tc.deleted)
+		keys := make([]string, 0, len(objects))
+		etags := make(map[string]string, len(objects))
+		for _, o := range objects {
+			keys = append(keys, o["key"].(string))
+			etags[o["key"].(string)] = o["etag"].(string)
+		}
+		assert.DeepEqual(t, keys, tc.expectedKeys)
+		assert.DeepEqual(t, etags, tc.expectedETag)
+		assert.Equal(t, cosSyncSourceHash(digests) == cosSyncSourceHash(synced), tc.synchronized, tc.name)
+	}
+}
@@ -0,0 +1,99 @@
+package cos_test
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIBMCosBucket_Objects_Sync_Basic(t *testing.T) {
+	serviceName := fmt.Sprintf("terraform_%d", acctest.RandIntRange(10, 100))
+	bucketName := fmt.Sprintf("terraform-objects-sync%d", acctest.RandIntRange(10, 100))
+	bucketRegion := "us"
+	bucketClass := "standard"
+	bucketRegionType := "cross_region_location"
+
+	sourceDir := t.TempDir()
+	writeSyncFile := func(name, content string) {
+		path := filepath.Join(sourceDir, name)
+		if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	writeSyncFile("index.html", "<html>index</html>")
+	writeSyncFile("css/site.css", "body {}")
+	writeSyncFile("notes.tmp", "skipped")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckIBMCosBucketDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMCosBucket_Objects_Sync_Basic(serviceName, bucketName, bucketRegion, bucketClass, sourceDir),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckIBMCosBucketExists("ibm_resource_instance.instance", "ibm_cos_bucket.bucket", bucketRegionType, bucketRegion, bucketName),
+					resource.TestCheckResourceAttr("ibm_cos_bucket_objects_sync.site", "objects.#", "2"),
+					resource.TestCheckResourceAttr("ibm_cos_bucket_objects_sync.site", "objects.0.key", "site/css/site.css"),
+					resource.TestCheckResourceAttr("ibm_cos_bucket_objects_sync.site", "objects.0.content_type", "text/css; charset=utf-8"),
+					resource.TestCheckResourceAttr("ibm_cos_bucket_objects_sync.site", "objects.1.key", "site/index.html"),
+					resource.TestCheckResourceAttrSet("ibm_cos_bucket_objects_sync.site", "source_hash"),
+				),
+			},
+			{
+				PreConfig: func() {
+					writeSyncFile("index.html", "<html>updated</html>")
+					os.Remove(filepath.Join(sourceDir, "css/site.css"))
+				},
+				Config: testAccCheckIBMCosBucket_Objects_Sync_Basic(serviceName, bucketName, bucketRegion, bucketClass, sourceDir),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_cos_bucket_objects_sync.site", "objects.#", "1"),
+					resource.TestCheckResourceAttr("ibm_cos_bucket_objects_sync.site", "objects.0.key", "site/index.html"),
+					resource.TestCheckResourceAttr("ibm_cos_bucket_objects_sync.site", "objects.0.size", "20"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMCosBucket_Objects_Sync_Basic(cosServiceName string, bucketName string, region string, storageClass string, sourceDir string) string {
+
+	return fmt.Sprintf(`
+	data "ibm_resource_group" "cos_group" {
+		name = "Default"
+	}
+
+	resource "ibm_resource_instance" "instance" {
+		name              = "%s"
+		service           = "cloud-object-storage"
+		plan              = "standard"
+		location          = "global"
+		resource_group_id = data.ibm_resource_group.cos_group.id
+	}
+	resource "ibm_cos_bucket" "bucket" {
+		bucket_name           = "%s"
+		resource_instance_id  = ibm_resource_instance.instance.id
+		cross_region_location = "%s"
+		storage_class         = "%s"
+		force_delete          = true
+	}
+	resource "ibm_cos_bucket_objects_sync" "site" {
+		bucket_crn      = ibm_cos_bucket.bucket.crn
+		bucket_location = ibm_cos_bucket.bucket.cross_region_location
+		source_dir      = "%s"
+		key_prefix      = "site/"
+		exclude         = ["*.tmp"]
+		content_type_overrides = {
+			".css" = "text/css; charset=utf-8"
+		}
+	}
+	`, cosServiceName, bucketName, region, storageClass, sourceDir)
+}
@@ -0,0 +1,197 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package cos
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	"github.com/IBM/ibm-cos-sdk-go/aws"
+	"github.com/IBM/ibm-cos-sdk-go/service/s3"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const cosAllUsersGroupURI = "http://acs.amazonaws.com/groups/global/AllUsers"
+
+func ResourceIBMCOSBucketPublicAccess() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceIBMCOSBucketPublicAccessCreate,
+		Read:   resourceIBMCOSBucketPublicAccessRead,
+		Update: resourceIBMCOSBucketPublicAccessUpdate,
+		Delete: resourceIBMCOSBucketPublicAccessDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceIBMCOSBucketConfigurationImport,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+		Schema: map[string]*schema.Schema{
+			"bucket_crn": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "COS bucket CRN",
+			},
+			"bucket_location": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "COS bucket location",
+			},
+			"endpoint_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validate.ValidateAllowedStringValues([]string{"public", "private", "direct"}),
+				Description:  "COS endpoint type: public, private, direct",
+				Default:      "public",
+			},
+			"public_read": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether anonymous users are allowed to read the objects of the bucket.",
+			},
+		},
+	}
+}
+
+func isPublicReadGrant(grant *s3.Grant) bool {
+	return grant != nil && grant.Grantee != nil && aws.StringValue(grant.Grantee.URI) == cosAllUsersGroupURI && aws.StringValue(grant.Permission) == s3.PermissionRead
+}
+
+// bucketPublicRead reports whether the access control list of a bucket grants read access to all users.
+func bucketPublicRead(grants []*s3.Grant) bool {
+	for _, grant := range grants {
+		if isPublicReadGrant(grant) {
+			return true
+		}
+	}
+	return false
+}
+
+// setBucketPublicReadGrants returns the grants with the read grant for all users added or removed. All other
+// grants are kept as they are.
+func setBucketPublicReadGrants(grants []*s3.Grant, publicRead bool) []*s3.Grant {
+	updated := make([]*s3.Grant, 0, len(grants)+1)
+	for _, grant := range grants {
+		if !isPublicReadGrant(grant) {
+			updated = append(updated, grant)
+		}
+	}
+	if publicRead {
+		updated = append(updated, &s3.Grant{
+			Grantee: &s3.Grantee{
+				Type: aws.String(s3.TypeGroup),
+				URI:  aws.String(cosAllUsersGroupURI),
+			},
+			Permission: aws.String(s3.PermissionRead),
+		})
+	}
+	return updated
+}
+
+// putBucketPublicRead adds or removes the read grant for all users on the access control list of the bucket,
+// without touching the grants that this resource does not manage.
+func putBucketPublicRead(meta interface{}, bucketName, bucketLocation, endpointType, instanceCRN string, publicRead bool) error {
+	bxSession, err := meta.(conns.ClientSession).BluemixSession()
+	if err != nil {
+		return err
+	}
+	s3Client, err := getS3ClientSession(bxSession, bucketLocation, endpointType, instanceCRN)
+	if err != nil {
+		return err
+	}
+	output, err := s3Client.GetBucketAcl(&s3.GetBucketAclInput{
+		Bucket: aws.String(bucketName),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to get the access control list of the COS bucket %s, %v", bucketName, err)
+	}
+	if bucketPublicRead(output.Grants) == publicRead {
+		return nil
+	}
+	putBucketAclInput := &s3.PutBucketAclInput{
+		Bucket: aws.String(bucketName),
+		AccessControlPolicy: &s3.AccessControlPolicy{
+			Grants: setBucketPublicReadGrants(output.Grants, publicRead),
+			Owner:  output.Owner,
+		},
+	}
+	_, err = s3Client.PutBucketAcl(putBucketAclInput)
+	if err != nil {
+		return fmt.Errorf("failed to update the access control list of the COS bucket %s, %v", bucketName, err)
+	}
+	return nil
+}
+
+func resourceIBMCOSBucketPublicAccessCreate(d *schema.ResourceData, meta interface{}) error {
+	bucketCRN := d.Get("bucket_crn").(string)
+	bucketName := strings.Split(bucketCRN, ":bucket:")[1]
+	instanceCRN := fmt.Sprintf("%s::", strings.Split(bucketCRN, ":bucket:")[0])
+	bucketLocation := d.Get("bucket_location").(string)
+	endpointType := d.Get("endpoint_type").(string)
+	err := putBucketPublicRead(meta, bucketName, bucketLocation, endpointType, instanceCRN, true)
+	if err != nil {
+		return err
+	}
+	bktID := fmt.Sprintf("%s:%s:%s:meta:%s:%s", strings.Replace(instanceCRN, "::", "", -1), "bucket", bucketName, bucketLocation, endpointType)
+	d.SetId(bktID)
+	return resourceIBMCOSBucketPublicAccessRead(d, meta)
+}
+
+func resourceIBMCOSBucketPublicAccessUpdate(d *schema.ResourceData, meta interface{}) error {
+	if d.HasChange("endpoint_type") {
+		d.SetId(fmt.Sprintf("%s:meta:%s:%s", parseWebsiteId(d.Id(), "bucketCRN"), parseWebsiteId(d.Id(), "bucketLocation"), d.Get("endpoint_type").(string)))
+	}
+	return resourceIBMCOSBucketPublicAccessRead(d, meta)
+}
+
+func resourceIBMCOSBucketPublicAccessRead(d *schema.ResourceData, meta interface{}) error {
+	bucketCRN := parseWebsiteId(d.Id(), "bucketCRN")
+	bucketName := parseWebsiteId(d.Id(), "bucketName")
+	bucketLocation := parseWebsiteId(d.Id(), "bucketLocation")
+	instanceCRN := parseWebsiteId(d.Id(), "instanceCRN")
+	endpointType := parseWebsiteId(d.Id(), "endpointType")
+	d.Set("bucket_crn", bucketCRN)
+	d.Set("bucket_location", bucketLocation)
+	if endpointType != "" {
+		d.Set("endpoint_type", endpointType)
+	}
+	bxSession, err := meta.(conns.ClientSession).BluemixSession()
+	if err != nil {
+		return err
+	}
+	s3Client, err := getS3ClientSession(bxSession, bucketLocation, endpointType, instanceCRN)
+	if err != nil {
+		return err
+	}
+	getBucketAclInput := &s3.GetBucketAclInput{
+		Bucket: aws.String(bucketName),
+	}
+	output, err := s3Client.GetBucketAcl(getBucketAclInput)
+	if err != nil {
+		return fmt.Errorf("failed to get the access control list of the COS bucket %s, %v", bucketName, err)
+	}
+	publicRead := bucketPublicRead(output.Grants)
+	if !publicRead {
+		// The public read grant was removed outside of Terraform.
+		d.SetId("")
+		return nil
+	}
+	d.Set("public_read", publicRead)
+	return nil
+}
+
+func resourceIBMCOSBucketPublicAccessDelete(d *schema.ResourceData, meta interface{}) error {
+	bucketName := parseWebsiteId(d.Id(), "bucketName")
+	bucketLocation := parseWebsiteId(d.Id(), "bucketLocation")
+	instanceCRN := parseWebsiteId(d.Id(), "instanceCRN")
+	endpointType := parseWebsiteId(d.Id(), "endpointType")
+	return putBucketPublicRead(meta, bucketName, bucketLocation, endpointType, instanceCRN, false)
+}
@@ -0,0 +1,58 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package cos
+
+import (
+	"testing"
+
+	"github.com/IBM/ibm-cos-sdk-go/aws"
+	"github.com/IBM/ibm-cos-sdk-go/service/s3"
+	"gotest.tools/assert"
+)
+
+func TestSetBucketPublicReadGrants(t *testing.T) {
+	ownerGrant := &s3.Grant{
+		Grantee:    &s3.Grantee{Type: aws.String(s3.TypeCanonicalUser), ID: aws.String("owner")},
+		Permission: aws.String(s3.PermissionFullControl),
+	}
+	readerGrant := &s3.Grant{
+		Grantee:    &s3.Grantee{Type: aws.String(s3.TypeCanonicalUser), ID: aws.String("reader")},
+		Permission: aws.String(s3.PermissionRead),
+	}
+	publicGrant := &s3.Grant{
+		Grantee:    &s3.Grantee{Type: aws.String(s3.TypeGroup), URI: aws.String(cosAllUsersGroupURI)},
+		Permission: aws.String(s3.PermissionRead),
+	}
+	testcases := []struct {
+		grants     []*s3.Grant
+		publicRead bool
+		expected   []*s3.Grant
+	}{
+		{
+			grants:     []*s3.Grant{ownerGrant, readerGrant},
+			publicRead: true,
+			expected:   []*s3.Grant{ownerGrant, readerGrant, publicGrant},
+		},
+		{
+			grants:     []*s3.Grant{ownerGrant, publicGrant, readerGrant},
+			publicRead: false,
+			expected:   []*s3.Grant{ownerGrant, readerGrant},
+		},
+		{
+			grants:     []*s3.Grant{ownerGrant, publicGrant},
+			publicRead: true,
+			expected:   []*s3.Grant{ownerGrant, publicGrant},
+		},
+		{
+			grants:     nil,
+			publicRead: false,
+			expected:   []*s3.Grant{},
+		},
+	}
+	for _, tc := range testcases {
+		grants := setBucketPublicReadGrants(tc.grants, tc.publicRead)
+		assert.DeepEqual(t, grants, tc.expected)
+		assert.Equal(t, bucketPublicRead(grants), tc.publicRead)
+	}
+}
@@ -18,11 +18,13 @@ import (
 
 func ResourceIBMCOSBucketWebsiteConfiguration() *schema.Resource {
 	return &schema.Resource{
-		Create:   resourceIBMCOSBucketWebsiteConfigurationCreate,
-		Read:     resourceIBMCOSBucketWebsiteConfigurationRead,
-		Update:   resourceIBMCOSBucketWebsiteConfigurationUpdate,
-		Delete:   resourceIBMCOSBucketWebsiteConfigurationDelete,
-		Importer: &schema.ResourceImporter{},
+		Create: resourceIBMCOSBucketWebsiteConfigurationCreate,
+		Read:   resourceIBMCOSBucketWebsiteConfigurationRead,
+		Update: resourceIBMCOSBucketWebsiteConfigurationUpdate,
+		Delete: resourceIBMCOSBucketWebsiteConfigurationDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceIBMCOSBucketConfigurationImport,
+		},
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(60 * time.Minute),
@@ -502,6 +504,27 @@ func parseWebsiteId(id string, info string) string {
 	return parseBucketId(bucketCRN, info)
 }
 
+// validateBucketConfigurationId checks that id has the format <bucket_crn>:meta:<bucket_location>:<endpoint_type>
+// of the IDs of the bucket configuration resources, which are parsed with parseWebsiteId.
+func validateBucketConfigurationId(id string) error {
+	parts := strings.Split(id, ":meta:")
+	if len(parts) != 2 || len(strings.Split(parts[0], ":bucket:")) != 2 {
+		return fmt.Errorf("[ERROR] Incorrect ID %s: ID should be a combination of bucketCRN:meta:bucketLocation:endpointType", id)
+	}
+	meta := strings.Split(parts[1], ":")
+	if len(meta) < 2 || meta[0] == "" || meta[1] == "" {
+		return fmt.Errorf("[ERROR] Incorrect ID %s: ID should be a combination of bucketCRN:meta:bucketLocation:endpointType", id)
+	}
+	return nil
+}
+
+func resourceIBMCOSBucketConfigurationImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	if err := validateBucketConfigurationId(d.Id()); err != nil {
+		return nil, err
+	}
+	return []*schema.ResourceData{d}, nil
+}
+
 func getWebsiteEndpoint(bucketName string, bucketLocation string) string {
 	return fmt.Sprintf("https://%s.s3-web.%s.cloud-object-storage.appdomain.cloud", bucketName, bucketLocation)
 }
@@ -0,0 +1,37 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package cos
+
+import (
+	"testing"
+
+	"gotest.tools/assert"
+)
+
+func TestValidateBucketConfigurationId(t *testing.T) {
+	bucketCRN := "crn:v1:bluemix:public:cloud-object-storage:global:a/1234:5678:bucket:my-bucket"
+	testcases := []struct {
+		id            string
+		expectedError bool
+	}{
+		{id: bucketCRN + ":meta:us-south:public"},
+		{id: bucketCRN + ":meta:us-south:private"},
+		{id: bucketCRN, expectedError: true},
+		{id: bucketCRN + ":meta:us-south", expectedError: true},
+		{id: bucketCRN + ":meta::public", expectedError: true},
+		{id: "crn:v1:bluemix:public:cloud-object-storage:global:a/1234:5678::meta:us-south:public", expectedError: true},
+		{id: "my-bucket", expectedError: true},
+		{id: "", expectedError: true},
+	}
+	for _, tc := range testcases {
+		err := validateBucketConfigurationId(tc.id)
+		if tc.expectedError {
+			assert.Assert(t, err != nil, "id %q", tc.id)
+			continue
+		}
+		assert.NilError(t, err, "id %q", tc.id)
+		assert.Equal(t, parseWebsiteId(tc.id, "bucketName"), "my-bucket")
+		assert.Equal(t, parseWebsiteId(tc.id, "bucketLocation"), "us-south")
+	}
+}
@@ -141,7 +141,9 @@ func ResourceIBMDatabaseInstance() *schema.Resource {
 		CustomizeDiff: customdiff.All(
 			resourceIBMDatabaseInstanceDiff,
 			validateGroupsDiff,
-			validateUsersDiff),
+			validateUsersDiff,
+			validatePromoteDiff,
+			validateVersionUpgradeDiff),
 
 		Importer: &schema.ResourceImporter{},
 
@@ -241,11 +243,15 @@ func ResourceIBMDatabaseInstance() *schema.Resource {
 				Description: "The configuration schema in JSON format",
 			},
 			"version": {
-				Description: "The database version to provision if specified",
+				Description: "The database version to provision if specified. Changing the version upgrades the database in place",
 				Type:        schema.TypeString,
 				Optional:    true,
 				Computed:    true,
-				ForceNew:    true,
+			},
+			"version_upgrade_skip_backup": {
+				Description: "Skip the backup that is taken before an in-place version upgrade",
+				Type:        schema.TypeBool,
+				Optional:    true,
 			},
 			"service_endpoints": {
 				Description:  "Types of the service endpoints. Possible values are 'public', 'private', 'public-and-private'.",
@@ -428,6 +434,7 @@ func ResourceIBMDatabaseInstance() *schema.Resource {
 			"allowlist": {
 				Type:     schema.TypeSet,
 				Optional: true,
+				Computed: true,
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"address": {
@@ -445,6 +452,22 @@ func ResourceIBMDatabaseInstance() *schema.Resource {
 					},
 				},
 			},
+			"promote": {
+				Description: "Promote the read-only replica to a full deployment. Adding the block to an existing read-only replica promotes it",
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"skip_initial_backup": {
+							Description: "Skip the initial backup of the promoted deployment",
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Default:     false,
+						},
+					},
+				},
+			},
 			"logical_replication_slot": {
 				Type:     schema.TypeSet,
 				Optional: true,
@@ -938,6 +961,7 @@ type Params struct {
 	PITRDeploymentID    string  `json:"point_in_time_recovery_deployment_id,omitempty"`
 	PITRTimeStamp       *string `json:"point_in_time_recovery_time,omitempty"`
 	OfflineRestore      bool    `json:"offline_restore,omitempty"`
+	SkipBackup          bool    `json:"skip_backup,omitempty"`
 }
 
 type Group struct {
@@ -1045,7 +1069,7 @@ type CountLimit struct {
 	CanScaleDown    bool
 }
 
-func resourceIBMDatabaseInstanceDiff(_ context.Context, diff *schema.ResourceDiff, meta interface{}) (err error) {
+func resourceIBMDatabaseInstanceDiff(context context.Context, diff *schema.ResourceDiff, meta interface{}) (err error) {
 	err = flex.ResourceTagsCustomizeDiff(diff)
 	if err != nil {
 		return err
@@ -1060,106 +1084,135 @@ func resourceIBMDatabaseInstanceDiff(_ context.Context, diff *schema.ResourceDif
 		return fmt.Errorf("[ERROR] logical_replication_slot is only supported for databases-for-postgresql")
 	}
 
-	configJSON, configOk := diff.GetOk("configuration")
+	if configJSON, ok := diff.GetOk("configuration"); ok {
+		if err = validateDatabaseConfiguration(service, configJSON.(string)); err != nil {
+			return err
+		}
+	}
 
-	if configOk {
-		var rawConfig map[string]json.RawMessage
-		err = json.Unmarshal([]byte(configJSON.(string)), &rawConfig)
-		if err != nil {
-			return fmt.Errorf("[ERROR] configuration JSON invalid\n%s", err)
+	// Users added to the users block must not exist yet: they are either managed by an ibm_database_user
+	// resource or were created outside of Terraform.
+	if diff.Id() != "" && diff.HasChange("users") {
+		if err = checkDatabaseUsersNotExist(context, diff, meta); err != nil {
+			return err
 		}
+	}
 
-		var unmarshalFn func(m map[string]json.RawMessage, result interface{}) (err error)
+	_, offlineRestoreOk := diff.GetOk("offline_restore")
+	if offlineRestoreOk && service != "databases-for-mongodb" && plan != "enterprise" {
+		return fmt.Errorf("[ERROR] offline_restore is only supported for databases-for-mongodb enterprise")
+	}
 
-		var configuration clouddatabasesv5.ConfigurationIntf = new(clouddatabasesv5.Configuration)
+	return nil
+}
 
-		switch service {
-		case "databases-for-postgresql":
-			unmarshalFn = clouddatabasesv5.UnmarshalConfigurationPgConfiguration
-		case "databases-for-enterprisedb":
-			unmarshalFn = clouddatabasesv5.UnmarshalConfigurationPgConfiguration
-		case "databases-for-redis":
-			unmarshalFn = clouddatabasesv5.UnmarshalConfigurationRedisConfiguration
-		case "databases-for-mysql":
-			unmarshalFn = clouddatabasesv5.UnmarshalConfigurationMySQLConfiguration
-		case "messages-for-rabbitmq":
-			unmarshalFn = clouddatabasesv5.UnmarshalConfigurationRabbitMqConfiguration
-		default:
-			return fmt.Errorf("[ERROR] configuration is not supported for %s", service)
-		}
-
-		err = core.UnmarshalModel(rawConfig, "", &configuration, unmarshalFn)
-		if err != nil {
-			return fmt.Errorf("[ERROR] configuration is invalid\n%s", err)
-		}
+// checkDatabaseUsersNotExist fails the plan if a user added to the users block already exists on the instance.
+func checkDatabaseUsersNotExist(context context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	cloudDatabasesClient, err := meta.(conns.ClientSession).CloudDatabasesV5()
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error getting database client settings: %s", err)
+	}
 
-		b, _ := json.Marshal(configuration)
-		var result map[string]json.RawMessage
-		json.Unmarshal(b, &result)
+	endpointType := "public"
+	if diff.Get("service_endpoints").(string) == "private" {
+		endpointType = "private"
+	}
 
-		invalidFields := []string{}
-		for k, _ := range rawConfig {
-			if _, ok := result[k]; !ok {
-				invalidFields = append(invalidFields, k)
-			}
+	oldUsers, newUsers := diff.GetChange("users")
+	for _, change := range expandUserChanges(oldUsers.(*schema.Set).List(), newUsers.(*schema.Set).List()) {
+		if !change.isCreate() {
+			continue
 		}
-
-		if len(invalidFields) != 0 {
-			return fmt.Errorf("[ERROR] configuration contained invalid field(s): %s", invalidFields)
+		exists, err := databaseUserExists(context, cloudDatabasesClient, diff.Id(), change.New.Type, change.New.Username, endpointType)
+		if err != nil {
+			return err
+		}
+		if exists {
+			return fmt.Errorf("[ERROR] User %s of type %s already exists on database (%s). It is managed by an ibm_database_user resource or was created outside of Terraform; remove it from the users block or import it as an ibm_database_user resource", change.New.Username, change.New.Type, diff.Id())
 		}
 	}
+	return nil
+}
 
-	_, offlineRestoreOk := diff.GetOk("offline_restore")
-	if offlineRestoreOk && service != "databases-for-mongodb" && plan != "enterprise" {
-		return fmt.Errorf("[ERROR] offline_restore is only supported for databases-for-mongodb enterprise")
+// validateDatabaseConfiguration checks that the configuration JSON only contains fields supported by the service.
+func validateDatabaseConfiguration(service string, configJSON string) error {
+	var rawConfig map[string]json.RawMessage
+	err := json.Unmarshal([]byte(configJSON), &rawConfig)
+	if err != nil {
+		return fmt.Errorf("[ERROR] configuration JSON invalid\n%s", err)
 	}
 
-	return nil
-}
+	var unmarshalFn func(m map[string]json.RawMessage, result interface{}) (err error)
 
-// Replace with func wrapper for resourceIBMResourceInstanceCreate specifying serviceName := "database......."
-func resourceIBMDatabaseInstanceCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	rsConClient, err := meta.(conns.ClientSession).ResourceControllerV2API()
+	var configuration clouddatabasesv5.ConfigurationIntf = new(clouddatabasesv5.Configuration)
+
+	switch service {
+	case "databases-for-postgresql":
+		unmarshalFn = clouddatabasesv5.UnmarshalConfigurationPgConfiguration
+	case "databases-for-enterprisedb":
+		unmarshalFn = clouddatabasesv5.UnmarshalConfigurationPgConfiguration
+	case "databases-for-redis":
+		unmarshalFn = clouddatabasesv5.UnmarshalConfigurationRedisConfiguration
+	case "databases-for-mysql":
+		unmarshalFn = clouddatabasesv5.UnmarshalConfigurationMySQLConfiguration
+	case "messages-for-rabbitmq":
+		unmarshalFn = clouddatabasesv5.UnmarshalConfigurationRabbitMqConfiguration
+	default:
+		return fmt.Errorf("[ERROR] configuration is not supported for %s", service)
+	}
+
+	err = core.UnmarshalModel(rawConfig, "", &configuration, unmarshalFn)
 	if err != nil {
-		return diag.FromErr(err)
+		return fmt.Errorf("[ERROR] configuration is invalid\n%s", err)
 	}
 
-	serviceName := d.Get("service").(string)
-	plan := d.Get("plan").(string)
-	name := d.Get("name").(string)
-	location := d.Get("location").(string)
+	b, _ := json.Marshal(configuration)
+	var result map[string]json.RawMessage
+	json.Unmarshal(b, &result)
 
-	rsInst := rc.CreateResourceInstanceOptions{
-		Name: &name,
+	invalidFields := []string{}
+	for k, _ := range rawConfig {
+		if _, ok := result[k]; !ok {
+			invalidFields = append(invalidFields, k)
+		}
 	}
 
+	if len(invalidFields) != 0 {
+		return fmt.Errorf("[ERROR] configuration contained invalid field(s): %s", invalidFields)
+	}
+
+	return nil
+}
+
+// getDatabaseCatalogTarget returns the plan ID and the catalog CRN of the deployment to create a database instance of the
+// service and plan at the location.
+func getDatabaseCatalogTarget(meta interface{}, serviceName, plan, location string) (string, string, error) {
 	rsCatClient, err := meta.(conns.ClientSession).ResourceCatalogAPI()
 	if err != nil {
-		return diag.FromErr(err)
+		return "", "", err
 	}
 	rsCatRepo := rsCatClient.ResourceCatalog()
 
 	serviceOff, err := rsCatRepo.FindByName(serviceName, true)
 	if err != nil {
-		return diag.FromErr(fmt.Errorf("[ERROR] Error retrieving database service offering: %s", err))
+		return "", "", fmt.Errorf("[ERROR] Error retrieving database service offering: %s", err)
 	}
 
 	servicePlan, err := rsCatRepo.GetServicePlanID(serviceOff[0], plan)
 	if err != nil {
-		return diag.FromErr(fmt.Errorf("[ERROR] Error retrieving plan: %s", err))
+		return "", "", fmt.Errorf("[ERROR] Error retrieving plan: %s", err)
 	}
-	rsInst.ResourcePlanID = &servicePlan
 
 	deployments, err := rsCatRepo.ListDeployments(servicePlan)
 	if err != nil {
 		if serviceName == "databases-for-mongodb" && plan == "enterprise-sharding" {
-			return diag.FromErr(fmt.Errorf("%s %s is not available yet in this region", serviceName, plan))
+			return "", "", fmt.Errorf("%s %s is not available yet in this region", serviceName, plan)
 		} else {
-			return diag.FromErr(fmt.Errorf("[ERROR] Error retrieving deployment for plan %s : %s", plan, err))
+			return "", "", fmt.Errorf("[ERROR] Error retrieving deployment for plan %s : %s", plan, err)
 		}
 	}
 	if len(deployments) == 0 {
-		return diag.FromErr(fmt.Errorf("[ERROR] No deployment found for service plan : %s", plan))
+		return "", "", fmt.Errorf("[ERROR] No deployment found for service plan : %s", plan)
 	}
 	deployments, supportedLocations := filterDatabaseDeployments(deployments, location)
 
@@ -1168,9 +1221,33 @@ func resourceIBMDatabaseInstanceCreate(context context.Context, d *schema.Resour
 		for l := range supportedLocations {
 			locationList = append(locationList, l)
 		}
-		return diag.FromErr(fmt.Errorf("[ERROR] No deployment found for service plan %s at location %s.\nValid location(s) are: %q", plan, location, locationList))
+		return "", "", fmt.Errorf("[ERROR] No deployment found for service plan %s at location %s.\nValid location(s) are: %q", plan, location, locationList)
+	}
+
+	return servicePlan, deployments[0].CatalogCRN, nil
+}
+
+// Replace with func wrapper for resourceIBMResourceInstanceCreate specifying serviceName := "database......."
+func resourceIBMDatabaseInstanceCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	rsConClient, err := meta.(conns.ClientSession).ResourceControllerV2API()
+	if err != nil {
+		return diag.FromErr(err)
 	}
-	catalogCRN := deployments[0].CatalogCRN
+
+	serviceName := d.Get("service").(string)
+	plan := d.Get("plan").(string)
+	name := d.Get("name").(string)
+	location := d.Get("location").(string)
+
+	rsInst := rc.CreateResourceInstanceOptions{
+		Name: &name,
+	}
+
+	servicePlan, catalogCRN, err := getDatabaseCatalogTarget(meta, serviceName, plan, location)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	rsInst.ResourcePlanID = &servicePlan
 	rsInst.Target = &catalogCRN
 
 	if rsGrpID, ok := d.GetOk("resource_group_id"); ok {
@@ -1776,6 +1853,53 @@ func resourceIBMDatabaseInstanceUpdate(context context.Context, d *schema.Resour
 		}
 	}
 
+	// A promoted replica can't be demoted, so only adding the block promotes the replica
+	oldPromote, newPromote := d.GetChange("promote")
+	promote := len(oldPromote.([]interface{})) == 0 && len(newPromote.([]interface{})) > 0
+
+	if promote {
+		promotion := map[string]interface{}{}
+		if p, ok := newPromote.([]interface{})[0].(map[string]interface{}); ok {
+			promotion["skip_initial_backup"] = p["skip_initial_backup"].(bool)
+		}
+		// Upgrade and promote in a single task
+		if d.HasChange("version") {
+			promotion["version"] = d.Get("version").(string)
+		}
+
+		err = promoteDatabaseReplica(instanceID, promotion, d, meta)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	oldVersion, newVersion := d.GetChange("version")
+	if d.HasChange("version") && !promote && !sameDatabaseMajorVersion(oldVersion.(string), newVersion.(string)) {
+		version := newVersion.(string)
+		params := Params{
+			Version:    version,
+			SkipBackup: d.Get("version_upgrade_skip_backup").(bool),
+		}
+		parameters, _ := json.Marshal(params)
+		var raw map[string]interface{}
+		json.Unmarshal(parameters, &raw)
+
+		upgradeReq := rc.UpdateResourceInstanceOptions{
+			ID:         &instanceID,
+			Parameters: raw,
+		}
+		_, response, err := rsConClient.UpdateResourceInstance(&upgradeReq)
+		if err != nil {
+			return diag.FromErr(fmt.Errorf("[ERROR] Error upgrading database (%s) to version %s: %s %s", instanceID, version, err, response))
+		}
+
+		err = waitForDatabaseVersionUpgrade(instanceID, version, d, meta, d.Timeout(schema.TimeoutUpdate))
+		if err != nil {
+			return diag.FromErr(fmt.Errorf(
+				"[ERROR] Error waiting for database (%s) upgrade to version %s to complete: %s", instanceID, version, err))
+		}
+	}
+
 	if d.HasChange("tags") {
 		oldList, newList := d.GetChange("tags")
 		err = flex.UpdateTagsUsingCRN(oldList, newList, meta, instanceID)
@@ -2221,11 +2345,22 @@ func getConnectionString(d *schema.ResourceData, userName, connectionEndpoint st
 }
 
 func resourceIBMDatabaseInstanceDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	rsConClient, err := meta.(conns.ClientSession).ResourceControllerV2API()
+	err := deleteDatabaseInstance(d, meta, d.Id())
 	if err != nil {
 		return diag.FromErr(err)
 	}
-	id := d.Id()
+
+	d.SetId("")
+
+	return nil
+}
+
+// deleteDatabaseInstance deletes the database instance and waits until it is removed.
+func deleteDatabaseInstance(d *schema.ResourceData, meta interface{}, id string) error {
+	rsConClient, err := meta.(conns.ClientSession).ResourceControllerV2API()
+	if err != nil {
+		return err
+	}
 	recursive := true
 	deleteReq := rc.DeleteResourceInstanceOptions{
 		Recursive: &recursive,
@@ -2240,20 +2375,19 @@ func resourceIBMDatabaseInstanceDelete(context context.Context, d *schema.Resour
 			log.Printf("[WARN] Resource instance already deleted %s\n ", err)
 			err = nil
 		} else {
-			return diag.FromErr(fmt.Errorf("[ERROR] Error deleting resource instance: %s %s ", err, response))
+			return fmt.Errorf("[ERROR] Error deleting resource instance: %s %s ", err, response)
 		}
 	}
 
-	_, err = waitForDatabaseInstanceDelete(d, meta)
+	_, err = waitForDatabaseInstanceDelete(d, meta, id)
 	if err != nil {
-		return diag.FromErr(fmt.Errorf(
-			"[ERROR] Error waiting for resource instance (%s) to be deleted: %s", d.Id(), err))
+		return fmt.Errorf(
+			"[ERROR] Error waiting for resource instance (%s) to be deleted: %s", id, err)
 	}
 
-	d.SetId("")
-
 	return nil
 }
+
 func resourceIBMDatabaseInstanceExists(d *schema.ResourceData, meta interface{}) (bool, error) {
 	rsConClient, err := meta.(conns.ClientSession).ResourceControllerV2API()
 	if err != nil {
@@ -2427,12 +2561,119 @@ func waitForDatabaseTaskComplete(taskId string, d *schema.ResourceData, meta int
 	}
 }
 
-func waitForDatabaseInstanceDelete(d *schema.ResourceData, meta interface{}) (interface{}, error) {
+// promoteDatabaseReplica promotes the read-only replica to a full deployment and waits for the task to complete.
+func promoteDatabaseReplica(instanceID string, promotion map[string]interface{}, d *schema.ResourceData, meta interface{}) error {
+	cloudDatabasesClient, err := meta.(conns.ClientSession).CloudDatabasesV5()
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error getting database client settings: %s", err)
+	}
+
+	promoteReadOnlyReplicaOptions := &clouddatabasesv5.PromoteReadOnlyReplicaOptions{
+		ID:        &instanceID,
+		Promotion: promotion,
+	}
+
+	promoteReadOnlyReplicaResponse, response, err := cloudDatabasesClient.PromoteReadOnlyReplica(promoteReadOnlyReplicaOptions)
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error promoting database (%s) read-only replica: %s\n%s", instanceID, err, response)
+	}
+
+	taskID := *promoteReadOnlyReplicaResponse.Task.ID
+	_, err = waitForDatabaseTaskComplete(taskID, d, meta, d.Timeout(schema.TimeoutUpdate))
+	if err != nil {
+		return fmt.Errorf(
+			"[ERROR] Error waiting for database (%s) promotion task to complete: %s", instanceID, err)
+	}
+
+	return nil
+}
+
+// waitForDatabaseVersionUpgrade waits for the tasks of the deployment until it runs the major version.
+func waitForDatabaseVersionUpgrade(instanceID string, version string, d *schema.ResourceData, meta interface{}, t time.Duration) error {
+	cloudDatabasesClient, err := meta.(conns.ClientSession).CloudDatabasesV5()
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error getting database client settings: %s", err)
+	}
+
+	// The tasks that were seen running, they are checked for failure when they are no longer listed as running
+	running := map[string]bool{}
+
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{"upgrading"},
+		Target:  []string{"upgraded"},
+		Refresh: func() (interface{}, string, error) {
+			tasks, response, err := cloudDatabasesClient.ListDeploymentTasks(&clouddatabasesv5.ListDeploymentTasksOptions{
+				ID: &instanceID,
+			})
+			if err != nil {
+				return nil, "", fmt.Errorf("[ERROR] Error listing database (%s) tasks: %s\n%s", instanceID, err, response)
+			}
+
+			active := map[string]bool{}
+			for _, task := range tasks.Tasks {
+				if task.ID != nil && task.Status != nil && (*task.Status == "queued" || *task.Status == "running") {
+					active[*task.ID] = true
+					running[*task.ID] = true
+				}
+			}
+			for taskID := range running {
+				if active[taskID] {
+					continue
+				}
+				getTaskResponse, response, err := cloudDatabasesClient.GetTask(&clouddatabasesv5.GetTaskOptions{
+					ID: core.StringPtr(taskID),
+				})
+				if err != nil {
+					return nil, "", fmt.Errorf("[ERROR] Error getting database (%s) task (%s): %s\n%s", instanceID, taskID, err, response)
+				}
+				if getTaskResponse.Task != nil && getTaskResponse.Task.Status != nil && *getTaskResponse.Task.Status == "failed" {
+					return nil, "", fmt.Errorf("[ERROR] Database (%s) task (%s) failed", instanceID, taskID)
+				}
+				delete(running, taskID)
+			}
+			if len(active) > 0 {
+				return tasks, "upgrading", nil
+			}
+
+			getDeploymentInfoResponse, response, err := cloudDatabasesClient.GetDeploymentInfo(&clouddatabasesv5.GetDeploymentInfoOptions{
+				ID: &instanceID,
+			})
+			if err != nil {
+				return nil, "", fmt.Errorf("[ERROR] Error getting database (%s): %s\n%s", instanceID, err, response)
+			}
+			deployment := getDeploymentInfoResponse.Deployment
+			if deployment.Version != nil && sameDatabaseMajorVersion(*deployment.Version, version) {
+				return deployment, "upgraded", nil
+			}
+			return deployment, "upgrading", nil
+		},
+		Timeout:    t,
+		Delay:      10 * time.Second,
+		MinTimeout: 10 * time.Second,
+	}
+
+	_, err = stateConf.WaitForState()
+	return err
+}
+
+// sameDatabaseMajorVersion reports whether the database versions have the same major version, for example 14 and 14.10.
+func sameDatabaseMajorVersion(version, other string) bool {
+	major, err := databaseMajorVersion(version)
+	if err != nil {
+		return version == other
+	}
+	otherMajor, err := databaseMajorVersion(other)
+	if err != nil {
+		return version == other
+	}
+	return major == otherMajor
+}
+
+func waitForDatabaseInstanceDelete(d *schema.ResourceData, meta interface{}, instanceID string) (interface{}, error) {
 	rsConClient, err := meta.(conns.ClientSession).ResourceControllerV2API()
 	if err != nil {
 		return false, err
 	}
-	instanceID := d.Id()
 	stateConf := &resource.StateChangeConf{
 		Pending: []string{databaseInstanceProgressStatus, databaseInstanceInactiveStatus, databaseInstanceSuccessStatus},
 		Target:  []string{databaseInstanceRemovedStatus, databaseInstanceReclamation},
@@ -2851,27 +3092,106 @@ func validateGroupsDiff(_ context.Context, diff *schema.ResourceDiff, meta inter
 	return nil
 }
 
+func validatePromoteDiff(_ context.Context, diff *schema.ResourceDiff, meta interface{}) (err error) {
+	oldPromote, newPromote := diff.GetChange("promote")
+	if len(newPromote.([]interface{})) == 0 {
+		return nil
+	}
+	if diff.Id() == "" {
+		return fmt.Errorf("[ERROR] promote can only be set on an existing read-only replica")
+	}
+	// Only adding the block promotes the replica, a promoted replica may drop remote_leader_id
+	if len(oldPromote.([]interface{})) == 0 && diff.NewValueKnown("remote_leader_id") && diff.Get("remote_leader_id").(string) == "" {
+		return fmt.Errorf("[ERROR] promote can only be set on a read-only replica, remote_leader_id is not set")
+	}
+
+	return nil
+}
+
+// databaseDeployableType returns the deployable type of the service, for example postgresql for databases-for-postgresql.
+func databaseDeployableType(service string) string {
+	for _, prefix := range []string{"databases-for-", "messages-for-"} {
+		if strings.HasPrefix(service, prefix) {
+			return strings.TrimPrefix(service, prefix)
+		}
+	}
+	return service
+}
+
+func validateVersionUpgradeDiff(_ context.Context, diff *schema.ResourceDiff, meta interface{}) (err error) {
+	if diff.Id() == "" || !diff.HasChange("version") || !diff.NewValueKnown("version") {
+		return nil
+	}
+
+	oldVersion, newVersion := diff.GetChange("version")
+	if newVersion.(string) == "" || oldVersion.(string) == "" {
+		return nil
+	}
+
+	cloudDatabasesClient, err := meta.(conns.ClientSession).CloudDatabasesV5()
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error getting database client settings: %s", err)
+	}
+
+	listDeployablesResponse, response, err := cloudDatabasesClient.ListDeployables(&clouddatabasesv5.ListDeployablesOptions{})
+	if err != nil {
+		log.Printf("[WARN] Skipping database version upgrade validation: %s\n%s", err, response)
+		return nil
+	}
+
+	deployableType := databaseDeployableType(diff.Get("service").(string))
+	allowed, ok := databaseVersionUpgradeAllowed(listDeployablesResponse.Deployables, deployableType, oldVersion.(string), newVersion.(string))
+	if ok {
+		return nil
+	}
+
+	return fmt.Errorf("[ERROR] version %s can't be upgraded to %s in place. Allowed versions: %q", oldVersion, newVersion, allowed)
+}
+
+// databaseVersionUpgradeAllowed reports whether the deployable type can be upgraded in place from the old to the
+// new version. The versions are compared by their major version, as the version of a deployment can include the
+// minor version. It returns the versions the old version can be upgraded to.
+func databaseVersionUpgradeAllowed(deployables []clouddatabasesv5.Deployables, deployableType, oldVersion, newVersion string) ([]string, bool) {
+	allowed := []string{}
+	if sameDatabaseMajorVersion(oldVersion, newVersion) {
+		return allowed, true
+	}
+	for _, deployable := range deployables {
+		if deployable.Type == nil || *deployable.Type != deployableType {
+			continue
+		}
+		for _, v := range deployable.Versions {
+			if v.Version == nil || !sameDatabaseMajorVersion(*v.Version, oldVersion) {
+				continue
+			}
+			for _, transition := range v.Transitions {
+				if transition.ToVersion != nil {
+					allowed = append(allowed, *transition.ToVersion)
+				}
+			}
+		}
+	}
+
+	for _, v := range allowed {
+		if sameDatabaseMajorVersion(v, newVersion) {
+			return allowed, true
+		}
+	}
+	return allowed, false
+}
+
 func validateUsersDiff(_ context.Context, diff *schema.ResourceDiff, meta interface{}) (err error) {
 	service := diff.Get("service").(string)
 
 	var versionStr string
-	var version int
 
 	if _version, ok := diff.GetOk("version"); ok {
 		versionStr = _version.(string)
 	}
 
-	if versionStr == "" {
-		// Latest Version
-		version = 0
-	} else {
-		_v, err := strconv.ParseFloat(versionStr, 64)
-
-		if err != nil {
-			return fmt.Errorf("invalid version: %s", versionStr)
-		}
-
-		version = int(_v)
+	version, err := databaseMajorVersion(versionStr)
+	if err != nil {
+		return err
 	}
 
 	oldUsers, newUsers := diff.GetChange("users")
@@ -2883,26 +3203,7 @@ func validateUsersDiff(_ context.Context, diff *schema.ResourceDiff, meta interf
 		}
 
 		if change.isCreate() || change.isUpdate() {
-			err = change.New.ValidatePassword()
-
-			if err != nil {
-				return err
-			}
-
-			// TODO: Use Capability API
-			// RBAC roles supported for Redis 6.0 and above
-			if service == "databases-for-redis" && !(version > 0 && version < 6) {
-				err = change.New.ValidateRBACRole()
-			} else if service == "databases-for-mongodb" && change.New.Type == "ops_manager" {
-				err = change.New.ValidateOpsManagerRole()
-			} else {
-				if change.New.Role != nil {
-					if *change.New.Role != "" {
-						err = errors.New("role is not supported for this deployment or user type")
-						err = &databaseUserValidationError{user: change.New, errs: []error{err}}
-					}
-				}
-			}
+			err = change.New.Validate(service, version)
 
 			if err != nil {
 				return err
@@ -2913,6 +3214,22 @@ func validateUsersDiff(_ context.Context, diff *schema.ResourceDiff, meta interf
 	return
 }
 
+// databaseMajorVersion returns the major version of the database, or 0 for the latest version.
+func databaseMajorVersion(versionStr string) (int, error) {
+	if versionStr == "" {
+		// Latest Version
+		return 0, nil
+	}
+
+	_v, err := strconv.ParseFloat(versionStr, 64)
+
+	if err != nil {
+		return 0, fmt.Errorf("invalid version: %s", versionStr)
+	}
+
+	return int(_v), nil
+}
+
 func expandUsers(_users []interface{}) []*DatabaseUser {
 	if len(_users) == 0 {
 		return nil
@@ -3099,6 +3416,32 @@ func (u *DatabaseUser) Delete(instanceID string, d *schema.ResourceData, meta in
 	return nil
 }
 
+// Validate checks the password and role of the user for the service and major version of the deployment.
+func (u *DatabaseUser) Validate(service string, version int) (err error) {
+	err = u.ValidatePassword()
+
+	if err != nil {
+		return err
+	}
+
+	// TODO: Use Capability API
+	// RBAC roles supported for Redis 6.0 and above
+	if service == "databases-for-redis" && !(version > 0 && version < 6) {
+		err = u.ValidateRBACRole()
+	} else if service == "databases-for-mongodb" && u.Type == "ops_manager" {
+		err = u.ValidateOpsManagerRole()
+	} else {
+		if u.Role != nil {
+			if *u.Role != "" {
+				err = errors.New("role is not supported for this deployment or user type")
+				err = &databaseUserValidationError{user: u, errs: []error{err}}
+			}
+		}
+	}
+
+	return err
+}
+
 func (u *DatabaseUser) isUpdatable() bool {
 	return u.Type != "ops_manager"
 }
@@ -0,0 +1,222 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package database
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	"github.com/IBM/cloud-databases-go-sdk/clouddatabasesv5"
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func ResourceIBMDatabaseAllowlistEntry() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIBMDatabaseAllowlistEntryCreate,
+		ReadContext:   resourceIBMDatabaseAllowlistEntryRead,
+		DeleteContext: resourceIBMDatabaseAllowlistEntryDelete,
+		CustomizeDiff: resourceIBMDatabaseAllowlistEntryDiff,
+
+		Importer: &schema.ResourceImporter{},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(20 * time.Minute),
+			Delete: schema.DefaultTimeout(20 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"deployment_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "ID of the database instance",
+			},
+			"address": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.ValidateCIDR,
+				Description:  "Allowlist IP address in CIDR notation",
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 32),
+				Description:  "Unique allow list description",
+			},
+		},
+	}
+}
+
+// parseDatabaseAllowlistEntryID splits the ID of an allowlist entry, <deployment_id>/<address>. Both the deployment ID
+// and the CIDR address contain slashes, so the address is formed from the last two parts.
+func parseDatabaseAllowlistEntryID(id string) (instanceID, address string, err error) {
+	parts := strings.Split(id, "/")
+	if len(parts) < 3 {
+		return "", "", fmt.Errorf("[ERROR] Incorrect ID %s: ID should be a combination of deployment_id/address", id)
+	}
+	return strings.Join(parts[:len(parts)-2], "/"), strings.Join(parts[len(parts)-2:], "/"), nil
+}
+
+func getDatabaseAllowlistEntry(context context.Context, cloudDatabasesClient *clouddatabasesv5.CloudDatabasesV5, instanceID, address string) (*clouddatabasesv5.AllowlistEntry, *core.DetailedResponse, error) {
+	allowlist, response, err := cloudDatabasesClient.GetAllowlistWithContext(context, &clouddatabasesv5.GetAllowlistOptions{
+		ID: core.StringPtr(instanceID),
+	})
+	if err != nil {
+		return nil, response, err
+	}
+	for _, entry := range allowlist.IPAddresses {
+		if entry.Address != nil && *entry.Address == address {
+			return &entry, response, nil
+		}
+	}
+	return nil, response, nil
+}
+
+func resourceIBMDatabaseAllowlistEntryDiff(context context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if diff.Id() != "" || !diff.NewValueKnown("deployment_id") || !diff.NewValueKnown("address") {
+		return nil
+	}
+
+	cloudDatabasesClient, err := meta.(conns.ClientSession).CloudDatabasesV5()
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error getting database client settings: %s", err)
+	}
+
+	// An address that is already allowed is managed elsewhere, either by the allowlist block of the
+	// ibm_database resource or by another ibm_database_allowlist_entry resource.
+	instanceID := diff.Get("deployment_id").(string)
+	address := diff.Get("address").(string)
+	existing, _, err := getDatabaseAllowlistEntry(context, cloudDatabasesClient, instanceID, address)
+	if err != nil {
+		// The deployment may not exist yet
+		log.Printf("[DEBUG] Skipping database allowlist entry (%s) check: %s", address, err)
+		return nil
+	}
+	if existing != nil {
+		return fmt.Errorf("[ERROR] Address %s is already in the allowlist of database (%s). Remove it from the allowlist block of the ibm_database resource or import it with terraform import", address, instanceID)
+	}
+	return nil
+}
+
+func resourceIBMDatabaseAllowlistEntryCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cloudDatabasesClient, err := meta.(conns.ClientSession).CloudDatabasesV5()
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error getting database client settings: %s", err))
+	}
+
+	instanceID := d.Get("deployment_id").(string)
+	address := d.Get("address").(string)
+
+	// An address that is already allowed is managed elsewhere, either by the allowlist block of the
+	// ibm_database resource or by another ibm_database_allowlist_entry resource.
+	existing, response, err := getDatabaseAllowlistEntry(context, cloudDatabasesClient, instanceID, address)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error getting database (%s) allowlist: %s\n%s", instanceID, err, response))
+	}
+	if existing != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Address %s is already in the allowlist of database (%s). Remove it from the allowlist block of the ibm_database resource or import it with terraform import", address, instanceID))
+	}
+
+	entry := &clouddatabasesv5.AllowlistEntry{
+		Address: core.StringPtr(address),
+	}
+	if description, ok := d.GetOk("description"); ok {
+		entry.Description = core.StringPtr(description.(string))
+	}
+
+	addAllowlistEntryResponse, response, err := cloudDatabasesClient.AddAllowlistEntryWithContext(context, &clouddatabasesv5.AddAllowlistEntryOptions{
+		ID:        core.StringPtr(instanceID),
+		IPAddress: entry,
+	})
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error adding database (%s) allowlist entry %s: %s\n%s", instanceID, address, err, response))
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", instanceID, address))
+
+	_, err = waitForDatabaseTaskComplete(*addAllowlistEntryResponse.Task.ID, d, meta, d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return diag.FromErr(fmt.Errorf(
+			"[ERROR] Error waiting for database (%s) allowlist entry %s add task to complete: %s", instanceID, address, err))
+	}
+
+	return resourceIBMDatabaseAllowlistEntryRead(context, d, meta)
+}
+
+func resourceIBMDatabaseAllowlistEntryRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cloudDatabasesClient, err := meta.(conns.ClientSession).CloudDatabasesV5()
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error getting database client settings: %s", err))
+	}
+
+	instanceID, address, err := parseDatabaseAllowlistEntryID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	entry, response, err := getDatabaseAllowlistEntry(context, cloudDatabasesClient, instanceID, address)
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			log.Printf("[WARN] Removing database allowlist entry (%s) from state because the database instance was not found", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(fmt.Errorf("[ERROR] Error getting database (%s) allowlist: %s\n%s", instanceID, err, response))
+	}
+	if entry == nil {
+		log.Printf("[WARN] Removing database allowlist entry (%s) from state because it's not found via the API", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("deployment_id", instanceID)
+	d.Set("address", entry.Address)
+	d.Set("description", entry.Description)
+
+	return nil
+}
+
+func resourceIBMDatabaseAllowlistEntryDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cloudDatabasesClient, err := meta.(conns.ClientSession).CloudDatabasesV5()
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error getting database client settings: %s", err))
+	}
+
+	instanceID, address, err := parseDatabaseAllowlistEntryID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	deleteAllowlistEntryResponse, response, err := cloudDatabasesClient.DeleteAllowlistEntryWithContext(context, &clouddatabasesv5.DeleteAllowlistEntryOptions{
+		ID:        core.StringPtr(instanceID),
+		Ipaddress: core.StringPtr(address),
+	})
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(fmt.Errorf("[ERROR] Error deleting database (%s) allowlist entry %s: %s\n%s", instanceID, address, err, response))
+	}
+
+	_, err = waitForDatabaseTaskComplete(*deleteAllowlistEntryResponse.Task.ID, d, meta, d.Timeout(schema.TimeoutDelete))
+	if err != nil {
+		return diag.FromErr(fmt.Errorf(
+			"[ERROR] Error waiting for database (%s) allowlist entry %s delete task to complete: %s", instanceID, address, err))
+	}
+
+	d.SetId("")
+
+	return nil
+}
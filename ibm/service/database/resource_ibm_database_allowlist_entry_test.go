@@ -0,0 +1,117 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package database_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIBMDatabaseAllowlistEntryBasic(t *testing.T) {
+	t.Parallel()
+	databaseResourceGroup := "default"
+	var databaseInstanceOne string
+	testName := fmt.Sprintf("tf-Pgress-%d", acctest.RandIntRange(10, 100))
+	name := "ibm_database_allowlist_entry.entry"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckIBMDatabaseInstanceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMDatabaseAllowlistEntryConfig(databaseResourceGroup, testName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckIBMDatabaseInstanceExists("ibm_database."+testName, &databaseInstanceOne),
+					resource.TestCheckResourceAttr(name, "address", "172.168.1.2/32"),
+					resource.TestCheckResourceAttr(name, "description", "app"),
+				),
+			},
+			{
+				ResourceName:      name,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config:      testAccCheckIBMDatabaseAllowlistEntryDuplicateConfig(databaseResourceGroup, testName),
+				ExpectError: regexp.MustCompile("is already in the allowlist"),
+			},
+			{
+				Config:      testAccCheckIBMDatabaseAllowlistEntryInlineConfig(databaseResourceGroup, testName),
+				ExpectError: regexp.MustCompile("is managed by both ibm_database and ibm_database_allowlist_entry"),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMDatabaseAllowlistEntryConfig(databaseResourceGroup string, name string) string {
+	return fmt.Sprintf(`
+	data "ibm_resource_group" "test_acc" {
+		name = "%[1]s"
+	}
+
+	resource "ibm_database" "%[2]s" {
+		resource_group_id = data.ibm_resource_group.test_acc.id
+		name              = "%[2]s"
+		service           = "databases-for-postgresql"
+		plan              = "standard"
+		location          = "%[3]s"
+		adminpassword     = "password12345678"
+
+		lifecycle {
+			ignore_changes = [allowlist]
+		}
+	}
+
+	resource "ibm_database_allowlist_entry" "entry" {
+		deployment_id = ibm_database.%[2]s.id
+		address       = "172.168.1.2/32"
+		description   = "app"
+	}
+	`, databaseResourceGroup, name, acc.Region())
+}
+
+func testAccCheckIBMDatabaseAllowlistEntryDuplicateConfig(databaseResourceGroup string, name string) string {
+	return testAccCheckIBMDatabaseAllowlistEntryConfig(databaseResourceGroup, name) + fmt.Sprintf(`
+	resource "ibm_database_allowlist_entry" "duplicate" {
+		deployment_id = ibm_database.%[1]s.id
+		address       = ibm_database_allowlist_entry.entry.address
+		description   = "duplicate"
+	}
+	`, name)
+}
+
+func testAccCheckIBMDatabaseAllowlistEntryInlineConfig(databaseResourceGroup string, name string) string {
+	return fmt.Sprintf(`
+	data "ibm_resource_group" "test_acc" {
+		name = "%[1]s"
+	}
+
+	resource "ibm_database" "%[2]s" {
+		resource_group_id = data.ibm_resource_group.test_acc.id
+		name              = "%[2]s"
+		service           = "databases-for-postgresql"
+		plan              = "standard"
+		location          = "%[3]s"
+		adminpassword     = "password12345678"
+
+		allowlist {
+			address     = "172.168.1.3/32"
+			description = "inline"
+		}
+	}
+
+	resource "ibm_database_allowlist_entry" "entry" {
+		deployment_id = ibm_database.%[2]s.id
+		address       = "172.168.1.2/32"
+		description   = "app"
+	}
+	`, databaseResourceGroup, name, acc.Region())
+}
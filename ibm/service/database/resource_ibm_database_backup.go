@@ -0,0 +1,183 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package database
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM/cloud-databases-go-sdk/clouddatabasesv5"
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func ResourceIBMDatabaseBackup() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIBMDatabaseBackupCreate,
+		ReadContext:   resourceIBMDatabaseBackupRead,
+		DeleteContext: resourceIBMDatabaseBackupDelete,
+
+		Importer: &schema.ResourceImporter{},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"deployment_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "ID of the database instance to back up",
+			},
+			"triggers": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				ForceNew:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Arbitrary values that, when changed, take a new backup",
+			},
+			"backup_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Backup ID",
+			},
+			"type": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The type of backup",
+			},
+			"status": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The status of the backup",
+			},
+			"is_downloadable": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Is the backup available to download?",
+			},
+			"is_restorable": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Can the backup be used to restore an instance?",
+			},
+			"created_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Date and time when the backup was created",
+			},
+		},
+	}
+}
+
+// findDatabaseOndemandBackup returns the latest on-demand backup of the deployment that was created after the time.
+func findDatabaseOndemandBackup(context context.Context, cloudDatabasesClient *clouddatabasesv5.CloudDatabasesV5, instanceID string, after time.Time) (*clouddatabasesv5.Backup, error) {
+	backups, response, err := cloudDatabasesClient.ListDeploymentBackupsWithContext(context, &clouddatabasesv5.ListDeploymentBackupsOptions{
+		ID: core.StringPtr(instanceID),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("[ERROR] Error listing database (%s) backups: %s\n%s", instanceID, err, response)
+	}
+
+	var latest *clouddatabasesv5.Backup
+	for i, backup := range backups.Backups {
+		if backup.Type == nil || *backup.Type != "on_demand" || backup.CreatedAt == nil {
+			continue
+		}
+		createdAt := time.Time(*backup.CreatedAt)
+		if createdAt.Before(after) {
+			continue
+		}
+		if latest == nil || createdAt.After(time.Time(*latest.CreatedAt)) {
+			latest = &backups.Backups[i]
+		}
+	}
+	return latest, nil
+}
+
+func resourceIBMDatabaseBackupCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cloudDatabasesClient, err := meta.(conns.ClientSession).CloudDatabasesV5()
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error getting database client settings: %s", err))
+	}
+
+	instanceID := d.Get("deployment_id").(string)
+
+	// Allow for clock skew between the client and the API
+	startedAt := time.Now().Add(-1 * time.Minute)
+
+	startOndemandBackupResponse, response, err := cloudDatabasesClient.StartOndemandBackupWithContext(context, &clouddatabasesv5.StartOndemandBackupOptions{
+		ID: core.StringPtr(instanceID),
+	})
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error starting database (%s) backup: %s\n%s", instanceID, err, response))
+	}
+
+	_, err = waitForDatabaseTaskComplete(*startOndemandBackupResponse.Task.ID, d, meta, d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return diag.FromErr(fmt.Errorf(
+			"[ERROR] Error waiting for database (%s) backup task to complete: %s", instanceID, err))
+	}
+
+	backup, err := findDatabaseOndemandBackup(context, cloudDatabasesClient, instanceID, startedAt)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if backup == nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] The database (%s) backup task completed but no on-demand backup was found", instanceID))
+	}
+
+	d.SetId(*backup.ID)
+
+	return resourceIBMDatabaseBackupRead(context, d, meta)
+}
+
+func resourceIBMDatabaseBackupRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cloudDatabasesClient, err := meta.(conns.ClientSession).CloudDatabasesV5()
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error getting database client settings: %s", err))
+	}
+
+	getBackupInfoResponse, response, err := cloudDatabasesClient.GetBackupInfoWithContext(context, &clouddatabasesv5.GetBackupInfoOptions{
+		BackupID: core.StringPtr(d.Id()),
+	})
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			// ICD deletes backups when their retention period ends. The record is kept, so that an expired
+			// backup doesn't take a new backup on the next apply; only changing triggers takes a new one.
+			log.Printf("[WARN] Database backup (%s) is not found via the API, it has expired", d.Id())
+			d.Set("status", "expired")
+			d.Set("is_downloadable", false)
+			d.Set("is_restorable", false)
+			return nil
+		}
+		return diag.FromErr(fmt.Errorf("[ERROR] Error getting database backup (%s): %s\n%s", d.Id(), err, response))
+	}
+
+	backup := getBackupInfoResponse.Backup
+
+	d.Set("backup_id", backup.ID)
+	d.Set("deployment_id", backup.DeploymentID)
+	d.Set("type", backup.Type)
+	d.Set("status", backup.Status)
+	d.Set("is_downloadable", backup.IsDownloadable)
+	d.Set("is_restorable", backup.IsRestorable)
+	d.Set("created_at", flex.DateTimeToString(backup.CreatedAt))
+
+	return nil
+}
+
+func resourceIBMDatabaseBackupDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	// ICD does not implement a DeleteBackup API. On-demand backups expire after their retention period.
+	log.Printf("[WARN] Database backup (%s) is removed from state only, it is deleted by ICD when it expires", d.Id())
+	d.SetId("")
+
+	return nil
+}
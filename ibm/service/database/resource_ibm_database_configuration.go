@@ -0,0 +1,232 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package database
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM/cloud-databases-go-sdk/clouddatabasesv5"
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func ResourceIBMDatabaseConfiguration() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIBMDatabaseConfigurationCreate,
+		ReadContext:   resourceIBMDatabaseConfigurationRead,
+		UpdateContext: resourceIBMDatabaseConfigurationUpdate,
+		DeleteContext: resourceIBMDatabaseConfigurationDelete,
+		CustomizeDiff: resourceIBMDatabaseConfigurationDiff,
+
+		Importer: &schema.ResourceImporter{},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+			Update: schema.DefaultTimeout(60 * time.Minute),
+			Delete: schema.DefaultTimeout(60 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"deployment_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "ID of the database instance",
+			},
+			"configuration": {
+				Type:     schema.TypeString,
+				Required: true,
+				StateFunc: func(v interface{}) string {
+					json, err := flex.NormalizeJSONString(v)
+					if err != nil {
+						return fmt.Sprintf("%q", err.Error())
+					}
+					return json
+				},
+				Description: "The configuration in JSON format",
+			},
+			"configuration_schema": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The configuration schema in JSON format",
+			},
+		},
+	}
+}
+
+func resourceIBMDatabaseConfigurationDiff(context context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if !diff.NewValueKnown("deployment_id") || !diff.NewValueKnown("configuration") {
+		return nil
+	}
+	service := databaseServiceName(diff.Get("deployment_id").(string))
+	return validateDatabaseConfiguration(service, diff.Get("configuration").(string))
+}
+
+// updateDatabaseConfiguration applies the configuration JSON to the database instance and waits for the task to complete.
+func updateDatabaseConfiguration(instanceID string, configJSON string, d *schema.ResourceData, meta interface{}, timeout time.Duration) error {
+	cloudDatabasesClient, err := meta.(conns.ClientSession).CloudDatabasesV5()
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error getting database client settings: %s", err)
+	}
+
+	var rawConfig map[string]json.RawMessage
+	err = json.Unmarshal([]byte(configJSON), &rawConfig)
+	if err != nil {
+		return fmt.Errorf("[ERROR] configuration JSON invalid\n%s", err)
+	}
+
+	var configuration clouddatabasesv5.ConfigurationIntf = new(clouddatabasesv5.Configuration)
+	err = core.UnmarshalModel(rawConfig, "", &configuration, clouddatabasesv5.UnmarshalConfiguration)
+	if err != nil {
+		return fmt.Errorf("[ERROR] database configuration is invalid")
+	}
+
+	updateDatabaseConfigurationOptions := &clouddatabasesv5.UpdateDatabaseConfigurationOptions{
+		ID:            core.StringPtr(instanceID),
+		Configuration: configuration,
+	}
+
+	updateDatabaseConfigurationResponse, response, err := cloudDatabasesClient.UpdateDatabaseConfiguration(updateDatabaseConfigurationOptions)
+	if err != nil {
+		return fmt.Errorf(
+			"[ERROR] Error updating database configuration failed %s\n%s", err, response)
+	}
+
+	taskID := *updateDatabaseConfigurationResponse.Task.ID
+
+	_, err = waitForDatabaseTaskComplete(taskID, d, meta, timeout)
+	if err != nil {
+		return fmt.Errorf(
+			"[ERROR] Error waiting for database (%s) configuration update task to complete: %s", instanceID, err)
+	}
+
+	return nil
+}
+
+func resourceIBMDatabaseConfigurationCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	instanceID := d.Get("deployment_id").(string)
+
+	err := updateDatabaseConfiguration(instanceID, d.Get("configuration").(string), d, meta, d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(instanceID)
+
+	return resourceIBMDatabaseConfigurationRead(context, d, meta)
+}
+
+func resourceIBMDatabaseConfigurationRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	instanceID := d.Id()
+
+	cloudDatabasesClient, err := meta.(conns.ClientSession).CloudDatabasesV5()
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error getting database client settings: %s", err))
+	}
+
+	_, response, err := cloudDatabasesClient.GetDeploymentInfoWithContext(context, &clouddatabasesv5.GetDeploymentInfoOptions{
+		ID: core.StringPtr(instanceID),
+	})
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			log.Printf("[WARN] Removing database configuration (%s) from state because the database instance was not found", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(fmt.Errorf("[ERROR] Error getting database (%s): %s\n%s", instanceID, err, response))
+	}
+
+	// ICD does not return the current configuration values. Configuration populated from tf configuration.
+	d.Set("deployment_id", instanceID)
+
+	icdClient, err := meta.(conns.ClientSession).ICDAPI()
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error getting database client settings: %s", err))
+	}
+
+	configSchema, err := icdClient.Configurations().GetConfiguration(flex.EscapeUrlParm(instanceID))
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error getting database (%s) configuration schema : %s", instanceID, err))
+	}
+	s, err := json.Marshal(configSchema)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error marshalling the database configuration schema: %s", err))
+	}
+
+	if err = d.Set("configuration_schema", string(s)); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting the database configuration schema: %s", err))
+	}
+
+	return nil
+}
+
+func resourceIBMDatabaseConfigurationUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if d.HasChange("configuration") {
+		err := updateDatabaseConfiguration(d.Id(), d.Get("configuration").(string), d, meta, d.Timeout(schema.TimeoutUpdate))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceIBMDatabaseConfigurationRead(context, d, meta)
+}
+
+// databaseConfigurationDefaults returns the configuration JSON that resets the fields of the configuration to the
+// defaults of the configuration schema. Fields without a default are left unchanged.
+func databaseConfigurationDefaults(configJSON string, configSchemaJSON string) (string, error) {
+	var config map[string]interface{}
+	if err := json.Unmarshal([]byte(configJSON), &config); err != nil {
+		return "", err
+	}
+
+	var configSchema struct {
+		Schema map[string]map[string]interface{} `json:"schema"`
+	}
+	if err := json.Unmarshal([]byte(configSchemaJSON), &configSchema); err != nil {
+		return "", err
+	}
+
+	defaults := make(map[string]interface{})
+	for field := range config {
+		if fieldSchema, ok := configSchema.Schema[field]; ok {
+			if value, ok := fieldSchema["default"]; ok {
+				defaults[field] = value
+				continue
+			}
+		}
+		log.Printf("[WARN] Database configuration field %s has no default and is left unchanged", field)
+	}
+
+	if len(defaults) == 0 {
+		return "", nil
+	}
+
+	b, err := json.Marshal(defaults)
+	return string(b), err
+}
+
+func resourceIBMDatabaseConfigurationDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	defaults, err := databaseConfigurationDefaults(d.Get("configuration").(string), d.Get("configuration_schema").(string))
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error getting database (%s) configuration defaults: %s", d.Id(), err))
+	}
+
+	if defaults != "" {
+		err = updateDatabaseConfiguration(d.Id(), defaults, d, meta, d.Timeout(schema.TimeoutDelete))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	d.SetId("")
+
+	return nil
+}
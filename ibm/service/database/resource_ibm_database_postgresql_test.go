@@ -281,6 +281,78 @@ func TestAccIBMDatabaseInstancePostgresPITR(t *testing.T) {
 	})
 }
 
+func TestAccIBMDatabaseInstancePostgresVersionUpgrade(t *testing.T) {
+	t.Parallel()
+	databaseResourceGroup := "default"
+	var databaseInstanceOne string
+	var databaseInstanceTwo string
+	rnd := fmt.Sprintf("tf-Pgress-%d", acctest.RandIntRange(10, 100))
+	testName := rnd
+	name := "ibm_database." + testName
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckIBMDatabaseInstanceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMDatabaseInstancePostgresVersion(databaseResourceGroup, testName, "15"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckIBMDatabaseInstanceExists(name, &databaseInstanceOne),
+					resource.TestCheckResourceAttr(name, "version", "15"),
+				),
+			},
+			{
+				Config: testAccCheckIBMDatabaseInstancePostgresVersion(databaseResourceGroup, testName, "16"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckIBMDatabaseInstanceExists(name, &databaseInstanceTwo),
+					resource.TestCheckResourceAttr(name, "version", "16"),
+					func(s *terraform.State) error {
+						if databaseInstanceOne != databaseInstanceTwo {
+							return fmt.Errorf("database instance was replaced instead of upgraded in place")
+						}
+						return nil
+					},
+				),
+			},
+		},
+	})
+}
+
+func TestAccIBMDatabaseInstancePostgresReadReplicaPromotion(t *testing.T) {
+	t.Parallel()
+	databaseResourceGroup := "default"
+	var databaseInstanceOne string
+	rnd := fmt.Sprintf("tf-Pgress-%d", acctest.RandIntRange(10, 100))
+	testName := rnd
+	replicaName := "ibm_database." + testName + "-replica"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckIBMDatabaseInstanceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMDatabaseInstancePostgresReadReplica(databaseResourceGroup, testName, ""),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckIBMDatabaseInstanceExists(replicaName, &databaseInstanceOne),
+					resource.TestCheckResourceAttrSet(replicaName, "remote_leader_id"),
+				),
+			},
+			{
+				Config: testAccCheckIBMDatabaseInstancePostgresReadReplica(databaseResourceGroup, testName, `
+		promote {
+			skip_initial_backup = true
+		}`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckIBMDatabaseInstanceExists(replicaName, &databaseInstanceOne),
+					resource.TestCheckResourceAttr(replicaName, "promote.0.skip_initial_backup", "true"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckIBMDatabaseInstanceDestroy(s *terraform.State) error {
 	rsContClient, err := acc.TestAccProvider.Meta().(conns.ClientSession).ResourceControllerV2API()
 	if err != nil {
@@ -737,3 +809,48 @@ func testAccCheckIBMDatabaseInstancePostgresMinimal_PITR(databaseResourceGroup s
 	}
 				`, databaseResourceGroup, name, acc.Region())
 }
+
+func testAccCheckIBMDatabaseInstancePostgresVersion(databaseResourceGroup string, name string, version string) string {
+	return fmt.Sprintf(`
+	data "ibm_resource_group" "test_acc" {
+		name = "%[1]s"
+	}
+
+	resource "ibm_database" "%[2]s" {
+		resource_group_id = data.ibm_resource_group.test_acc.id
+		name              = "%[2]s"
+		service           = "databases-for-postgresql"
+		plan              = "standard"
+		location          = "%[3]s"
+		version           = "%[4]s"
+		adminpassword     = "password12345678"
+	}
+				`, databaseResourceGroup, name, acc.Region(), version)
+}
+
+func testAccCheckIBMDatabaseInstancePostgresReadReplica(databaseResourceGroup string, name string, promote string) string {
+	return fmt.Sprintf(`
+	data "ibm_resource_group" "test_acc" {
+		name = "%[1]s"
+	}
+
+	resource "ibm_database" "%[2]s" {
+		resource_group_id = data.ibm_resource_group.test_acc.id
+		name              = "%[2]s"
+		service           = "databases-for-postgresql"
+		plan              = "standard"
+		location          = "%[3]s"
+		adminpassword     = "password12345678"
+	}
+
+	resource "ibm_database" "%[2]s-replica" {
+		resource_group_id = data.ibm_resource_group.test_acc.id
+		name              = "%[2]s-replica"
+		service           = "databases-for-postgresql"
+		plan              = "standard"
+		location          = "%[3]s"
+		remote_leader_id  = ibm_database.%[2]s.id
+		%[4]s
+	}
+				`, databaseResourceGroup, name, acc.Region(), promote)
+}
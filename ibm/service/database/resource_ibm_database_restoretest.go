@@ -0,0 +1,276 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package database
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	"github.com/IBM/cloud-databases-go-sdk/clouddatabasesv5"
+	"github.com/IBM/go-sdk-core/v5/core"
+	rc "github.com/IBM/platform-services-go-sdk/resourcecontrollerv2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func ResourceIBMDatabaseRestoreTest() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIBMDatabaseRestoreTestCreate,
+		ReadContext:   resourceIBMDatabaseRestoreTestRead,
+		DeleteContext: resourceIBMDatabaseRestoreTestDelete,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(120 * time.Minute),
+			Delete: schema.DefaultTimeout(20 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"backup_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "ID of the backup to restore",
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Name of the temporary database instance the backup is restored into",
+			},
+			"plan": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Default:     "standard",
+				Description: "The plan of the temporary database instance",
+			},
+			"location": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: "The location of the temporary database instance. Defaults to the location of the backup",
+			},
+			"resource_group_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "The resource group of the temporary database instance",
+			},
+			"service_endpoints": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      "public",
+				ValidateFunc: validate.ValidateAllowedStringValues([]string{"public", "private", "public-and-private"}),
+				Description:  "Types of the service endpoints of the temporary database instance",
+			},
+			"backup_encryption_key_crn": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "The Backup Encryption Key CRN of the temporary database instance",
+			},
+			"triggers": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				ForceNew:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Arbitrary values that, when changed, run a new restore test",
+			},
+			"service": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The service of the restored backup",
+			},
+			"restored_instance_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "ID of the temporary database instance, which is deleted after the restore test",
+			},
+			"restored_version": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The database version of the temporary database instance",
+			},
+			"started_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Date and time when the restore was started",
+			},
+			"completed_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Date and time when the temporary database instance was ready",
+			},
+			"duration": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Number of seconds the restore took until the temporary database instance was ready",
+			},
+			"status": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The outcome of the restore test, succeeded or failed",
+			},
+			"failure_reason": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The reason the restore failed",
+			},
+		},
+	}
+}
+
+func resourceIBMDatabaseRestoreTestCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cloudDatabasesClient, err := meta.(conns.ClientSession).CloudDatabasesV5()
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error getting database client settings: %s", err))
+	}
+
+	backupID := d.Get("backup_id").(string)
+
+	getBackupInfoResponse, response, err := cloudDatabasesClient.GetBackupInfoWithContext(context, &clouddatabasesv5.GetBackupInfoOptions{
+		BackupID: core.StringPtr(backupID),
+	})
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error getting database backup (%s): %s\n%s", backupID, err, response))
+	}
+	backup := getBackupInfoResponse.Backup
+	if backup.IsRestorable == nil || !*backup.IsRestorable {
+		return diag.FromErr(fmt.Errorf("[ERROR] Database backup (%s) is not restorable", backupID))
+	}
+
+	serviceName := databaseServiceName(*backup.DeploymentID)
+	plan := d.Get("plan").(string)
+	location := d.Get("location").(string)
+	if location == "" {
+		// The location is the sixth part of the deployment CRN
+		if parts := strings.Split(*backup.DeploymentID, ":"); len(parts) > 5 {
+			location = parts[5]
+		}
+	}
+
+	servicePlan, catalogCRN, err := getDatabaseCatalogTarget(meta, serviceName, plan, location)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	name := d.Get("name").(string)
+	rsInst := rc.CreateResourceInstanceOptions{
+		Name:           &name,
+		ResourcePlanID: &servicePlan,
+		Target:         &catalogCRN,
+	}
+
+	if rsGrpID, ok := d.GetOk("resource_group_id"); ok {
+		rgID := rsGrpID.(string)
+		rsInst.ResourceGroup = &rgID
+	} else {
+		defaultRg, err := flex.DefaultResourceGroup(meta)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		rsInst.ResourceGroup = &defaultRg
+	}
+
+	params := Params{
+		BackupID:         backupID,
+		ServiceEndpoints: d.Get("service_endpoints").(string),
+	}
+	if backUpEncryptionKey, ok := d.GetOk("backup_encryption_key_crn"); ok {
+		params.BackUpEncryptionCRN = backUpEncryptionKey.(string)
+	}
+	parameters, _ := json.Marshal(params)
+	var raw map[string]interface{}
+	json.Unmarshal(parameters, &raw)
+	rsInst.Parameters = raw
+
+	rsConClient, err := meta.(conns.ClientSession).ResourceControllerV2API()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	startedAt := time.Now()
+
+	instance, response, err := rsConClient.CreateResourceInstance(&rsInst)
+	if err != nil {
+		return diag.FromErr(
+			fmt.Errorf("[ERROR] Error creating database instance from backup (%s): %s %s", backupID, err, response))
+	}
+	instanceID := *instance.ID
+
+	// The temporary instance is deleted whether the restore succeeded or not
+	restoreErr := waitForDatabaseRestoreReady(d, meta, instanceID)
+	completedAt := time.Now()
+
+	deleteErr := deleteDatabaseInstance(d, meta, instanceID)
+
+	// The outcome is recorded also when the restore failed. The error taints the resource, so that the
+	// next apply runs the restore test again.
+	d.SetId(instanceID)
+	d.Set("location", location)
+	d.Set("service", serviceName)
+	d.Set("restored_instance_id", instanceID)
+	d.Set("started_at", startedAt.UTC().Format(time.RFC3339))
+	d.Set("completed_at", completedAt.UTC().Format(time.RFC3339))
+	d.Set("duration", int(completedAt.Sub(startedAt).Seconds()))
+
+	if restoreErr != nil {
+		d.Set("status", "failed")
+		d.Set("failure_reason", restoreErr.Error())
+		if deleteErr != nil {
+			return diag.FromErr(fmt.Errorf("[ERROR] Error restoring database backup (%s) into instance (%s): %s\nThe temporary instance (%s) was not deleted and must be deleted manually: %s", backupID, instanceID, restoreErr, instanceID, deleteErr))
+		}
+		return diag.FromErr(fmt.Errorf("[ERROR] Error restoring database backup (%s) into instance (%s): %s", backupID, instanceID, restoreErr))
+	}
+	d.Set("status", "succeeded")
+	d.Set("failure_reason", "")
+	if deleteErr != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Database backup (%s) was restored but the temporary instance (%s) was not deleted: %s", backupID, instanceID, deleteErr))
+	}
+
+	return resourceIBMDatabaseRestoreTestRead(context, d, meta)
+}
+
+// waitForDatabaseRestoreReady waits until the instance restored from a backup is active and its deployment can be read.
+func waitForDatabaseRestoreReady(d *schema.ResourceData, meta interface{}, instanceID string) error {
+	_, err := waitForDatabaseInstanceCreate(d, meta, instanceID)
+	if err != nil {
+		return err
+	}
+
+	cloudDatabasesClient, err := meta.(conns.ClientSession).CloudDatabasesV5()
+	if err != nil {
+		return err
+	}
+
+	getDeploymentInfoResponse, response, err := cloudDatabasesClient.GetDeploymentInfo(&clouddatabasesv5.GetDeploymentInfoOptions{
+		ID: core.StringPtr(instanceID),
+	})
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error getting database (%s): %s\n%s", instanceID, err, response)
+	}
+
+	d.Set("restored_version", getDeploymentInfoResponse.Deployment.Version)
+
+	return nil
+}
+
+func resourceIBMDatabaseRestoreTestRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	// The restore test is a record of a completed restore, the temporary instance no longer exists.
+	return nil
+}
+
+func resourceIBMDatabaseRestoreTestDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	d.SetId("")
+
+	return nil
+}
@@ -0,0 +1,53 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package database_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIBMDatabaseRestoreTestBasic(t *testing.T) {
+	t.Parallel()
+	databaseResourceGroup := "default"
+	var databaseInstanceOne string
+	testName := fmt.Sprintf("tf-Pgress-%d", acctest.RandIntRange(10, 100))
+	name := "ibm_database_restore_test.restore"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckIBMDatabaseInstanceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMDatabaseRestoreTestConfig(databaseResourceGroup, testName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckIBMDatabaseInstanceExists("ibm_database."+testName, &databaseInstanceOne),
+					resource.TestCheckResourceAttr(name, "service", "databases-for-postgresql"),
+					resource.TestCheckResourceAttr(name, "location", acc.Region()),
+					resource.TestMatchResourceAttr(name, "restored_instance_id", regexp.MustCompile("^crn:")),
+					resource.TestCheckResourceAttrSet(name, "restored_version"),
+					resource.TestCheckResourceAttrSet(name, "duration"),
+					resource.TestCheckResourceAttr(name, "status", "succeeded"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMDatabaseRestoreTestConfig(databaseResourceGroup string, name string) string {
+	return testAccCheckIBMDatabaseBackupConfig(databaseResourceGroup, name) + fmt.Sprintf(`
+	resource "ibm_database_restore_test" "restore" {
+		backup_id         = ibm_database_backup.backup.backup_id
+		name              = "%[1]s-restore"
+		resource_group_id = data.ibm_resource_group.test_acc.id
+	}
+	`, name)
+}
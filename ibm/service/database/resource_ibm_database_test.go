@@ -4,6 +4,7 @@
 package database
 
 import (
+	"github.com/IBM/cloud-databases-go-sdk/clouddatabasesv5"
 	"github.com/IBM/go-sdk-core/v5/core"
 	"gotest.tools/assert"
 	"testing"
@@ -193,3 +194,58 @@ func TestValidateRBACRole(t *testing.T) {
 		}
 	}
 }
+
+func TestDatabaseVersionUpgradeAllowed(t *testing.T) {
+	deployables := []clouddatabasesv5.Deployables{
+		{
+			Type: core.StringPtr("postgresql"),
+			Versions: []clouddatabasesv5.DeployablesVersionsItem{
+				{
+					Version: core.StringPtr("13"),
+					Transitions: []clouddatabasesv5.DeployablesVersionsItemTransitionsItem{
+						{ToVersion: core.StringPtr("14")},
+						{ToVersion: core.StringPtr("15")},
+					},
+				},
+				{
+					Version: core.StringPtr("14"),
+					Transitions: []clouddatabasesv5.DeployablesVersionsItemTransitionsItem{
+						{ToVersion: core.StringPtr("15")},
+					},
+				},
+			},
+		},
+		{
+			Type: core.StringPtr("mysql"),
+			Versions: []clouddatabasesv5.DeployablesVersionsItem{
+				{
+					Version: core.StringPtr("5.7"),
+					Transitions: []clouddatabasesv5.DeployablesVersionsItemTransitionsItem{
+						{ToVersion: core.StringPtr("8.0")},
+					},
+				},
+			},
+		},
+	}
+	testcases := []struct {
+		deployableType string
+		oldVersion     string
+		newVersion     string
+		allowed        []string
+		ok             bool
+	}{
+		{deployableType: "postgresql", oldVersion: "13", newVersion: "15", allowed: []string{"14", "15"}, ok: true},
+		{deployableType: "postgresql", oldVersion: "13.14", newVersion: "14", allowed: []string{"14", "15"}, ok: true},
+		{deployableType: "postgresql", oldVersion: "13", newVersion: "15.6", allowed: []string{"14", "15"}, ok: true},
+		{deployableType: "postgresql", oldVersion: "14.11", newVersion: "14", allowed: []string{}, ok: true},
+		{deployableType: "postgresql", oldVersion: "14", newVersion: "16", allowed: []string{"15"}, ok: false},
+		{deployableType: "postgresql", oldVersion: "15", newVersion: "14", allowed: []string{}, ok: false},
+		{deployableType: "mysql", oldVersion: "5.7", newVersion: "8.0", allowed: []string{"8.0"}, ok: true},
+		{deployableType: "mysql", oldVersion: "5.7", newVersion: "15", allowed: []string{"8.0"}, ok: false},
+	}
+	for _, tc := range testcases {
+		allowed, ok := databaseVersionUpgradeAllowed(deployables, tc.deployableType, tc.oldVersion, tc.newVersion)
+		assert.Equal(t, ok, tc.ok, "%s %s to %s", tc.deployableType, tc.oldVersion, tc.newVersion)
+		assert.DeepEqual(t, allowed, tc.allowed)
+	}
+}
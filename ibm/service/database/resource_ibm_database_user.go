@@ -0,0 +1,307 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package database
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	"github.com/IBM/cloud-databases-go-sdk/clouddatabasesv5"
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func ResourceIBMDatabaseUser() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIBMDatabaseUserCreate,
+		ReadContext:   resourceIBMDatabaseUserRead,
+		UpdateContext: resourceIBMDatabaseUserUpdate,
+		DeleteContext: resourceIBMDatabaseUserDelete,
+		CustomizeDiff: resourceIBMDatabaseUserDiff,
+
+		Importer: &schema.ResourceImporter{},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(20 * time.Minute),
+			Update: schema.DefaultTimeout(20 * time.Minute),
+			Delete: schema.DefaultTimeout(20 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"deployment_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "ID of the database instance",
+			},
+			"type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      "database",
+				ValidateFunc: validate.ValidateAllowedStringValues([]string{"database", "ops_manager", "read_only_replica"}),
+				Description:  "User type",
+			},
+			"username": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(5, 32),
+				Description:  "User name",
+			},
+			"password": {
+				Type:         schema.TypeString,
+				Required:     true,
+				Sensitive:    true,
+				ValidateFunc: validation.StringLenBetween(15, 32),
+				Description:  "User password",
+			},
+			"role": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "User role. Only available for ops_manager user type or Redis 6.0 and above.",
+			},
+		},
+	}
+}
+
+// databaseUserID returns the ID of a database user, <deployment_id>/<type>/<username>.
+func databaseUserID(instanceID, userType, username string) string {
+	return fmt.Sprintf("%s/%s/%s", instanceID, userType, username)
+}
+
+// parseDatabaseUserID splits the ID of a database user. The deployment ID is a CRN which contains slashes,
+// so the ID is split from the right.
+func parseDatabaseUserID(id string) (instanceID, userType, username string, err error) {
+	parts := strings.Split(id, "/")
+	if len(parts) < 3 {
+		return "", "", "", fmt.Errorf("[ERROR] Incorrect ID %s: ID should be a combination of deployment_id/type/username", id)
+	}
+	return strings.Join(parts[:len(parts)-2], "/"), parts[len(parts)-2], parts[len(parts)-1], nil
+}
+
+// databaseServiceName returns the service name, for example databases-for-postgresql, from the CRN of a database instance.
+func databaseServiceName(instanceID string) string {
+	parts := strings.Split(instanceID, ":")
+	if len(parts) < 5 {
+		return ""
+	}
+	return parts[4]
+}
+
+func expandDatabaseUser(d *schema.ResourceData) *DatabaseUser {
+	user := &DatabaseUser{
+		Username: d.Get("username").(string),
+		Password: d.Get("password").(string),
+		Type:     d.Get("type").(string),
+	}
+	if role, ok := d.GetOk("role"); ok {
+		user.Role = core.StringPtr(role.(string))
+	}
+	return user
+}
+
+// databaseUserExists reports whether the user exists on the instance. ICD does not implement a GetUser API, so the
+// connection of the user is looked up instead. Connections are not available for every user type, so a user is
+// only reported as missing if its connection is not found.
+func databaseUserExists(context context.Context, cloudDatabasesClient *clouddatabasesv5.CloudDatabasesV5, instanceID, userType, username, endpointType string) (bool, error) {
+	_, response, err := cloudDatabasesClient.GetConnectionWithContext(context, &clouddatabasesv5.GetConnectionOptions{
+		ID:           core.StringPtr(instanceID),
+		UserType:     core.StringPtr(userType),
+		UserID:       core.StringPtr(username),
+		EndpointType: core.StringPtr(endpointType),
+	})
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			return false, nil
+		}
+		if response == nil || response.StatusCode >= 500 {
+			return false, fmt.Errorf("[ERROR] Error getting database (%s) user %s: %s\n%s", instanceID, username, err, response)
+		}
+		log.Printf("[DEBUG] Could not check database (%s) user %s: %s\n%s", instanceID, username, err, response)
+	}
+	return true, nil
+}
+
+func resourceIBMDatabaseUserDiff(context context.Context, diff *schema.ResourceDiff, meta interface{}) (err error) {
+	if !diff.NewValueKnown("password") || !diff.NewValueKnown("role") || !diff.NewValueKnown("deployment_id") {
+		return nil
+	}
+	if diff.Id() != "" && !diff.HasChange("password") && !diff.HasChange("role") {
+		return nil
+	}
+
+	user := &DatabaseUser{
+		Username: diff.Get("username").(string),
+		Password: diff.Get("password").(string),
+		Type:     diff.Get("type").(string),
+	}
+	if role, ok := diff.GetOk("role"); ok {
+		user.Role = core.StringPtr(role.(string))
+	}
+
+	err = user.ValidatePassword()
+	if err != nil {
+		return err
+	}
+
+	instanceID := diff.Get("deployment_id").(string)
+	cloudDatabasesClient, err := meta.(conns.ClientSession).CloudDatabasesV5()
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error getting database client settings: %s", err)
+	}
+	getDeploymentInfoResponse, _, err := cloudDatabasesClient.GetDeploymentInfoWithContext(context, &clouddatabasesv5.GetDeploymentInfoOptions{
+		ID: core.StringPtr(instanceID),
+	})
+	if err != nil {
+		// The deployment may not exist yet
+		log.Printf("[DEBUG] Skipping database user (%s) validation: %s", user.Username, err)
+		return nil
+	}
+
+	// Users that already exist are either managed by the users block of the ibm_database resource or were
+	// created outside of Terraform, and must be imported instead.
+	if diff.Id() == "" {
+		endpointType := "public"
+		if deployment := getDeploymentInfoResponse.Deployment; deployment != nil && deployment.EnablePublicEndpoints != nil && !*deployment.EnablePublicEndpoints {
+			endpointType = "private"
+		}
+		exists, err := databaseUserExists(context, cloudDatabasesClient, instanceID, user.Type, user.Username, endpointType)
+		if err != nil {
+			return err
+		}
+		if exists {
+			return fmt.Errorf("[ERROR] User %s of type %s already exists on database (%s). Remove it from the users block of the ibm_database resource or import it with terraform import", user.Username, user.Type, instanceID)
+		}
+	}
+
+	// The role depends on the service and version of the deployment
+	if user.Role == nil {
+		return nil
+	}
+
+	version, err := databaseMajorVersion(*getDeploymentInfoResponse.Deployment.Version)
+	if err != nil {
+		return err
+	}
+
+	return user.Validate(databaseServiceName(instanceID), version)
+}
+
+func resourceIBMDatabaseUserCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	instanceID := d.Get("deployment_id").(string)
+	user := expandDatabaseUser(d)
+
+	// Users that already exist are not taken over: they are either managed by the users block of the
+	// ibm_database resource or by another ibm_database_user resource, and must be imported instead.
+	err := user.Create(instanceID, d, meta)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("%s\nIf the user already exists, remove it from the users block of the ibm_database resource or import it with terraform import", err))
+	}
+
+	d.SetId(databaseUserID(instanceID, user.Type, user.Username))
+
+	return resourceIBMDatabaseUserRead(context, d, meta)
+}
+
+func resourceIBMDatabaseUserRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	instanceID, userType, username, err := parseDatabaseUserID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	cloudDatabasesClient, err := meta.(conns.ClientSession).CloudDatabasesV5()
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error getting database client settings: %s", err))
+	}
+
+	getDeploymentInfoResponse, response, err := cloudDatabasesClient.GetDeploymentInfoWithContext(context, &clouddatabasesv5.GetDeploymentInfoOptions{
+		ID: core.StringPtr(instanceID),
+	})
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			log.Printf("[WARN] Removing database user (%s) from state because the database instance was not found", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(fmt.Errorf("[ERROR] Error getting database (%s): %s\n%s", instanceID, err, response))
+	}
+
+	endpointType := "public"
+	if deployment := getDeploymentInfoResponse.Deployment; deployment != nil && deployment.EnablePublicEndpoints != nil && !*deployment.EnablePublicEndpoints {
+		endpointType = "private"
+	}
+	exists, err := databaseUserExists(context, cloudDatabasesClient, instanceID, userType, username, endpointType)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if !exists {
+		log.Printf("[WARN] Removing database user (%s) from state because it's not found via the API", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	// Password and role are populated from tf configuration.
+	d.Set("deployment_id", instanceID)
+	d.Set("type", userType)
+	d.Set("username", username)
+
+	return nil
+}
+
+func resourceIBMDatabaseUserUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	instanceID, _, _, err := parseDatabaseUserID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if d.HasChange("password") || d.HasChange("role") {
+		user := expandDatabaseUser(d)
+
+		// Note: User Update is not supported for ops_manager user type
+		// Delete, then re-create
+		if !user.isUpdatable() {
+			err = user.Delete(instanceID, d, meta)
+			if err == nil {
+				err = user.Create(instanceID, d, meta)
+			}
+		} else {
+			err = user.Update(instanceID, d, meta)
+		}
+
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceIBMDatabaseUserRead(context, d, meta)
+}
+
+func resourceIBMDatabaseUserDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	instanceID, userType, username, err := parseDatabaseUserID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	user := &DatabaseUser{
+		Username: username,
+		Type:     userType,
+	}
+
+	err = user.Delete(instanceID, d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId("")
+
+	return nil
+}
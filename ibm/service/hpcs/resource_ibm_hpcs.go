@@ -700,6 +700,10 @@ func expandHSMConfig(d *schema.ResourceData, meta interface{}) tkesdk.HsmConfig
 	if r, ok := d.GetOk("revocation_threshold"); ok {
 		hsmConfig.RevocationThreshold = r.(int)
 	}
+	hsmConfig.Admins = expandHSMAdmins(d)
+	return hsmConfig
+}
+func expandHSMAdmins(d *schema.ResourceData) []tkesdk.AdminInfo {
 	if a, ok := d.GetOk("admins"); ok {
 		ads := a.(*schema.Set).List()
 		admins := []tkesdk.AdminInfo{}
@@ -712,9 +716,9 @@ func expandHSMConfig(d *schema.ResourceData, meta interface{}) tkesdk.HsmConfig
 			}
 			admins = append(admins, admin)
 		}
-		hsmConfig.Admins = admins
+		return admins
 	}
-	return hsmConfig
+	return nil
 }
 func resourceIBMHPCSDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	rsConClient, err := meta.(conns.ClientSession).ResourceControllerV2API()
@@ -0,0 +1,695 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package hpcs
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"log"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/IBM/ibm-hpcs-tke-sdk/common"
+	"github.com/IBM/ibm-hpcs-tke-sdk/ep11cmds"
+	"github.com/IBM/ibm-hpcs-tke-sdk/tkesdk"
+	rc "github.com/IBM/platform-services-go-sdk/resourcecontrollerv2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/service/resourcecontroller"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+)
+
+// Steps of the master key ceremony, in the order they are run
+const (
+	hpcsMasterKeyStepLoad         = "load"
+	hpcsMasterKeyStepCommit       = "commit"
+	hpcsMasterKeyStepSetImmediate = "set_immediate"
+)
+
+var hpcsMasterKeySteps = []string{hpcsMasterKeyStepLoad, hpcsMasterKeyStepCommit, hpcsMasterKeyStepSetImmediate}
+
+// tkeSignServURLMutex serializes the ceremonies of ibm_hpcs_master_key_rotation resources, because the TKE SDK reads
+// the signing service URL from the TKE_SIGNSERV_URL environment variable of the process.
+var tkeSignServURLMutex sync.Mutex
+
+// lockTKESignServURL locks tkeSignServURLMutex and sets TKE_SIGNSERV_URL to signature_server_url if it is set. The
+// returned function restores TKE_SIGNSERV_URL and unlocks the mutex.
+func lockTKESignServURL(d *schema.ResourceData) (func(), error) {
+	tkeSignServURLMutex.Lock()
+	url, ok := d.GetOk("signature_server_url")
+	if !ok {
+		return tkeSignServURLMutex.Unlock, nil
+	}
+	previous, wasSet := os.LookupEnv("TKE_SIGNSERV_URL")
+	err := os.Setenv("TKE_SIGNSERV_URL", url.(string))
+	if err != nil {
+		tkeSignServURLMutex.Unlock()
+		return nil, err
+	}
+	return func() {
+		if wasSet {
+			os.Setenv("TKE_SIGNSERV_URL", previous)
+		} else {
+			os.Unsetenv("TKE_SIGNSERV_URL")
+		}
+		tkeSignServURLMutex.Unlock()
+	}, nil
+}
+
+func ResourceIBMHPCSMasterKeyRotation() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIBMHPCSMasterKeyRotationCreate,
+		ReadContext:   resourceIBMHPCSMasterKeyRotationRead,
+		UpdateContext: resourceIBMHPCSMasterKeyRotationUpdate,
+		DeleteContext: resourceIBMHPCSMasterKeyRotationDelete,
+		CustomizeDiff: resourceIBMHPCSMasterKeyRotationDiff,
+
+		Schema: map[string]*schema.Schema{
+			"instance_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "GUID of the HPCS instance",
+			},
+			"location": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The location of the HPCS instance",
+			},
+			"service_endpoints": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.ValidateAllowedStringValues([]string{"public-and-private", "private-only"}),
+				Description:  "Types of the service endpoints of the HPCS instance",
+			},
+			"signature_server_url": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "URL of signing service",
+			},
+			"admins": {
+				Type:        schema.TypeSet,
+				Required:    true,
+				Description: "Crypto Unit Administrators that sign the ceremony commands",
+				Set:         resourceIBMHPCSAdminHash,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "Admin Name",
+						},
+						"key": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "The administrator signature key",
+						},
+						"token": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Sensitive:   true,
+							Description: "Credential giving access to the administrator signature key",
+						},
+					},
+				},
+			},
+			"target_step": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      hpcsMasterKeyStepSetImmediate,
+				ValidateFunc: validate.ValidateAllowedStringValues(hpcsMasterKeySteps),
+				Description:  "The last step of the ceremony to run: load, commit or set_immediate",
+			},
+			"triggers": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				ForceNew:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Arbitrary values that, when changed, start a new master key rotation",
+			},
+			"step": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The last step of the ceremony that completed on all crypto units",
+			},
+			"new_mkvp": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Verification pattern of the new master key register of the crypto units",
+			},
+			"current_mkvp": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Verification pattern of the current master key register of the crypto units",
+			},
+			"started_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The date the ceremony was started",
+			},
+			"completed_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The date the new master key was set immediate on all crypto units",
+			},
+			"hsm_info": ResourceIBMHPCS().Schema["hsm_info"],
+		},
+	}
+}
+
+func hpcsMasterKeyStepIndex(step string) int {
+	for i, s := range hpcsMasterKeySteps {
+		if s == step {
+			return i
+		}
+	}
+	return -1
+}
+
+func resourceIBMHPCSMasterKeyRotationDiff(context context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if diff.Id() == "" || !diff.HasChange("target_step") {
+		return nil
+	}
+	// A replaced rotation starts a new ceremony
+	if diff.HasChanges("triggers", "instance_id", "location", "service_endpoints") {
+		return nil
+	}
+	step := diff.Get("step").(string)
+	target := diff.Get("target_step").(string)
+	if hpcsMasterKeyStepIndex(target) < hpcsMasterKeyStepIndex(step) {
+		return fmt.Errorf("[ERROR] target_step can't be changed to %s because the ceremony already completed step %s. Change triggers to start a new master key rotation", target, step)
+	}
+	return nil
+}
+
+// hpcsCryptoUnit is a crypto unit of the instance with its master key registers and the administrators
+// installed in it.
+type hpcsCryptoUnit struct {
+	domain             common.DomainEntry
+	info               ep11cmds.DomainInfoRspInfo
+	signatureThreshold int
+	adminSKIs          []string
+}
+
+func (u hpcsCryptoUnit) isRecovery() bool {
+	return u.domain.Type == "recovery"
+}
+
+// hpcsMasterKeyCeremony runs the steps of a master key rotation against the crypto units of an instance.
+type hpcsMasterKeyCeremony struct {
+	authToken      string
+	urlStart       string
+	domains        []common.DomainEntry
+	sigKeyMap      map[string]string
+	sigKeyTokenMap map[string]string
+}
+
+func newHPCSMasterKeyCeremony(d *schema.ResourceData, meta interface{}) (*hpcsMasterKeyCeremony, error) {
+	ci, err := hsmClient(d, meta)
+	if err != nil {
+		return nil, err
+	}
+	ci.InstanceId = d.Get("instance_id").(string)
+
+	urlStart, err := common.GetBaseURL(ci.ApiEndpoint, ci.Region)
+	if err != nil {
+		return nil, err
+	}
+	domains, err := hpcsCryptoUnitDomains(ci.AuthToken, urlStart, ci.InstanceId)
+	if err != nil {
+		return nil, fmt.Errorf("[ERROR] Error Querying Crypto Units: %s", err)
+	}
+	if len(domains) == 0 {
+		return nil, fmt.Errorf("[ERROR] HPCS instance %s has no crypto units", ci.InstanceId)
+	}
+	_, sigKeyMap, sigKeyTokenMap, _, err := tkesdk.GetSignatureKeysFromResourceBlock(tkesdk.HsmConfig{Admins: expandHSMAdmins(d)})
+	if err != nil {
+		return nil, fmt.Errorf("[ERROR] Error Reading Administrator Signature Keys: %s", err)
+	}
+
+	return &hpcsMasterKeyCeremony{
+		authToken:      ci.AuthToken,
+		urlStart:       urlStart,
+		domains:        domains,
+		sigKeyMap:      sigKeyMap,
+		sigKeyTokenMap: sigKeyTokenMap,
+	}, nil
+}
+
+// hpcsCryptoModule is a crypto module that holds crypto units of an instance.
+type hpcsCryptoModule struct {
+	oaCertificate []byte
+	publicKey     string
+	serialNum     string
+}
+
+// OA certificates of CEX6P crypto modules start with this byte, older crypto modules use the CEX5P format.
+const hpcsOA2CertificateTag = 0x45
+
+// readHPCSCryptoModule reads the OA certificate and the serial number of the crypto module of a crypto unit.
+func readHPCSCryptoModule(authToken, urlStart string, domain common.DomainEntry) (*hpcsCryptoModule, error) {
+	// The epoch OA certificate is read with the current OA signature key
+	oaCertificate, err := ep11cmds.QueryDeviceCertificate(authToken, urlStart, domain, 0)
+	if err != nil {
+		return nil, err
+	}
+	if len(oaCertificate) == 0 {
+		return nil, fmt.Errorf("[ERROR] Crypto unit %s returned no OA certificate", domain.Hsm_id)
+	}
+
+	module := &hpcsCryptoModule{oaCertificate: oaCertificate}
+	if oaCertificate[0] == hpcsOA2CertificateTag {
+		var cert ep11cmds.OA2CertificateX
+		if err = cert.Init(oaCertificate); err != nil {
+			return nil, err
+		}
+		module.publicKey = hex.EncodeToString(cert.SpkiPublicKey)
+	} else {
+		var cert ep11cmds.OACertificateX
+		if err = cert.Init(oaCertificate); err != nil {
+			return nil, err
+		}
+		module.publicKey = hex.EncodeToString(cert.PublicKey)
+	}
+
+	// The OA signature of the response is checked with the public key of the crypto module
+	domain.Public_key = module.publicKey
+	_, attributes, err := ep11cmds.QueryDomainAttributes(authToken, urlStart, domain)
+	if err != nil {
+		return nil, err
+	}
+	module.serialNum = attributes.GetSerialNumber()
+	return module, nil
+}
+
+// verifyOACertificateChain verifies the OA certificate chain of the crypto module.
+func (m *hpcsCryptoModule) verifyOACertificateChain(authToken, urlStart string, domain common.DomainEntry) error {
+	if m.oaCertificate[0] == hpcsOA2CertificateTag {
+		var cert ep11cmds.OA2CertificateX
+		if err := cert.Init(m.oaCertificate); err != nil {
+			return err
+		}
+		return ep11cmds.VerifyOA2Certificate(authToken, urlStart, domain, 0, cert)
+	}
+	var cert ep11cmds.OACertificateX
+	if err := cert.Init(m.oaCertificate); err != nil {
+		return err
+	}
+	return ep11cmds.VerifyCertificate(authToken, urlStart, domain, 0, cert)
+}
+
+// hpcsCryptoUnitDomains returns the crypto units assigned to the instance. The TKE SDK does not export its list of
+// crypto units, so the list is built the same way: the serial numbers reported for the crypto units must match the
+// crypto modules, and the OA certificate chain of each crypto module is verified.
+func hpcsCryptoUnitDomains(authToken, urlStart, cryptoInstance string) ([]common.DomainEntry, error) {
+	hsmIDs, locations, serialNums, hsmTypes, err := common.SubmitQueryDomainsRequest(
+		common.CreateGetHsmsRequest(authToken, urlStart, cryptoInstance))
+	if err != nil {
+		return nil, err
+	}
+
+	// Crypto units of the same crypto module share the location without the domain index
+	modules := make(map[string]*hpcsCryptoModule)
+	domains := make([]common.DomainEntry, 0, len(hsmIDs))
+	for i := range hsmIDs {
+		domain := common.DomainEntry{
+			Domain_num:         i + 1,
+			Hsm_id:             hsmIDs[i],
+			Crypto_instance_id: cryptoInstance,
+			Location:           locations[i],
+			Type:               hsmTypes[i],
+			Selected:           true,
+		}
+		partialLocation := common.GetPartialLocation(locations[i])
+		module, ok := modules[partialLocation]
+		if !ok {
+			module, err = readHPCSCryptoModule(authToken, urlStart, domain)
+			if err != nil {
+				return nil, err
+			}
+			modules[partialLocation] = module
+		}
+		if serialNums[i] != module.serialNum {
+			return nil, errors.New("Serial number mismatch detected")
+		}
+		domain.Serial_num = module.serialNum
+		domain.Public_key = module.publicKey
+		domains = append(domains, domain)
+	}
+
+	verified := make(map[string]bool)
+	for _, domain := range domains {
+		if verified[domain.Serial_num] {
+			continue
+		}
+		if err := modules[common.GetPartialLocation(domain.Location)].verifyOACertificateChain(authToken, urlStart, domain); err != nil {
+			return nil, err
+		}
+		verified[domain.Serial_num] = true
+	}
+	return domains, nil
+}
+
+// cryptoUnits queries the master key registers, signature threshold and administrators of the crypto units.
+func (c *hpcsMasterKeyCeremony) cryptoUnits() ([]hpcsCryptoUnit, error) {
+	units := make([]hpcsCryptoUnit, 0, len(c.domains))
+	for _, domain := range c.domains {
+		info, err := ep11cmds.QueryDomainInfo(c.authToken, c.urlStart, domain)
+		if err != nil {
+			return nil, fmt.Errorf("[ERROR] Error Querying Master Key Registers of Crypto Unit %s: %s", domain.Hsm_id, err)
+		}
+		attributes, _, err := ep11cmds.QueryDomainAttributes(c.authToken, c.urlStart, domain)
+		if err != nil {
+			return nil, fmt.Errorf("[ERROR] Error Querying Attributes of Crypto Unit %s: %s", domain.Hsm_id, err)
+		}
+		admins, err := ep11cmds.QueryDomainAdmins(c.authToken, c.urlStart, domain)
+		if err != nil {
+			return nil, fmt.Errorf("[ERROR] Error Querying Administrators of Crypto Unit %s: %s", domain.Hsm_id, err)
+		}
+		adminSKIs := make([]string, 0, len(admins))
+		for _, ski := range admins {
+			adminSKIs = append(adminSKIs, hex.EncodeToString(ski))
+		}
+		units = append(units, hpcsCryptoUnit{
+			domain:             domain,
+			info:               info,
+			signatureThreshold: int(attributes.SignatureThreshold),
+			adminSKIs:          adminSKIs,
+		})
+	}
+	return units, nil
+}
+
+// signatureKeys returns the signature keys of the administrators that are installed in the crypto unit. Unlike
+// collectSigKeys of the TKE SDK it reports an error when too few administrators are available.
+func (c *hpcsMasterKeyCeremony) signatureKeys(unit hpcsCryptoUnit, needed int) ([]string, []string, []string, error) {
+	if needed < 1 {
+		needed = 1
+	}
+	sigkeys := make([]string, 0, needed)
+	sigkeySkis := make([]string, 0, needed)
+	sigkeyTokens := make([]string, 0, needed)
+	for _, ski := range unit.adminSKIs {
+		if len(sigkeys) == needed {
+			break
+		}
+		if key, ok := c.sigKeyMap[ski]; ok {
+			sigkeys = append(sigkeys, key)
+			sigkeySkis = append(sigkeySkis, ski)
+			sigkeyTokens = append(sigkeyTokens, c.sigKeyTokenMap[ski])
+		}
+	}
+	if len(sigkeys) < needed {
+		return nil, nil, nil, fmt.Errorf("[ERROR] Crypto unit %s requires %d signatures but only %d of the admins are administrators of the crypto unit", unit.domain.Hsm_id, needed, len(sigkeys))
+	}
+	return sigkeys, sigkeySkis, sigkeyTokens, nil
+}
+
+// load generates a random new master key in the recovery crypto unit and copies it to the new master key register
+// of the other crypto units. Crypto units whose new master key register already holds the new master key are
+// skipped, so a load that failed part way can be run again.
+func (c *hpcsMasterKeyCeremony) load(units []hpcsCryptoUnit) error {
+	recovery := -1
+	for i, unit := range units {
+		if unit.isRecovery() && unit.info.CurrentMKStatus == ep11cmds.CMK_STATUS_VALID {
+			recovery = i
+			break
+		}
+	}
+	if recovery == -1 {
+		return errors.New("[ERROR] No recovery crypto unit found whose current master key register is set. The recovery crypto unit is required to generate and distribute the new master key")
+	}
+	source := units[recovery]
+
+	if source.info.NewMKStatus == ep11cmds.MK_STATUS_EMPTY {
+		sigkeys, sigkeySkis, sigkeyTokens, err := c.signatureKeys(source, 1)
+		if err != nil {
+			return err
+		}
+		log.Printf("[INFO] Generating new master key in recovery crypto unit %s", source.domain.Hsm_id)
+		err, _ = ep11cmds.CreateRandomWK(c.authToken, c.urlStart, source.domain, sigkeys, sigkeySkis, sigkeyTokens)
+		if err != nil {
+			return fmt.Errorf("[ERROR] Error Generating New Master Key in Crypto Unit %s: %s", source.domain.Hsm_id, err)
+		}
+		source.info, err = ep11cmds.QueryDomainInfo(c.authToken, c.urlStart, source.domain)
+		if err != nil {
+			return fmt.Errorf("[ERROR] Error Querying Master Key Registers of Crypto Unit %s: %s", source.domain.Hsm_id, err)
+		}
+	}
+
+	for i, unit := range units {
+		if i == recovery {
+			continue
+		}
+		if unit.info.NewMKStatus != ep11cmds.MK_STATUS_EMPTY {
+			if bytes.Equal(unit.info.NewMKVP, source.info.NewMKVP) {
+				continue
+			}
+			return fmt.Errorf("[ERROR] The new master key register of crypto unit %s holds a master key (%x) that is not the new master key of the recovery crypto unit (%x). Clear the new master key register of the crypto unit with the TKE CLI plug-in and apply again", unit.domain.Hsm_id, unit.info.NewMKVP, source.info.NewMKVP)
+		}
+		if unit.info.CurrentMKStatus != ep11cmds.CMK_STATUS_VALID {
+			return fmt.Errorf("[ERROR] The current master key register of crypto unit %s is not set. Initialize the crypto units with the ibm_hpcs resource first", unit.domain.Hsm_id)
+		}
+
+		sigkeys, sigkeySkis, sigkeyTokens, err := c.signatureKeys(unit, 1)
+		if err != nil {
+			return err
+		}
+		exportSigkeys, exportSigkeySkis, exportSigkeyTokens, err := c.signatureKeys(source, source.signatureThreshold)
+		if err != nil {
+			return err
+		}
+
+		log.Printf("[INFO] Loading new master key in crypto unit %s", unit.domain.Hsm_id)
+		// Generate an importer key in the target crypto unit and export the new master key of the recovery
+		// crypto unit with it
+		pubKey, _, err := ep11cmds.GenerateP521ECImporterKey(c.authToken, c.urlStart, unit.domain, sigkeys, sigkeySkis, sigkeyTokens)
+		if err != nil {
+			return fmt.Errorf("[ERROR] Error Generating Importer Key in Crypto Unit %s: %s", unit.domain.Hsm_id, err)
+		}
+		pfile := ep11cmds.ExportWKParameterFile(ep11cmds.KPHCert(pubKey))
+		pdata, err := ep11cmds.ExportPendingWK(c.authToken, c.urlStart, source.domain, pfile, exportSigkeys, exportSigkeySkis, exportSigkeyTokens)
+		if err != nil {
+			return fmt.Errorf("[ERROR] Error Exporting New Master Key from Crypto Unit %s: %s", source.domain.Hsm_id, err)
+		}
+		var pMap common.ParameterMap
+		pMap, err = pMap.Load(pdata)
+		if err != nil {
+			return fmt.Errorf("[ERROR] Error Reading Exported New Master Key: %s", err)
+		}
+		recipientInfo := [][]byte{pMap.GetDataUsingIndex(common.PMTAG_ENCR_KEY_PART, 0)}
+		err = ep11cmds.ImportWK(c.authToken, c.urlStart, unit.domain, recipientInfo, sigkeys, sigkeySkis, sigkeyTokens)
+		if err != nil {
+			return fmt.Errorf("[ERROR] Error Importing New Master Key in Crypto Unit %s: %s", unit.domain.Hsm_id, err)
+		}
+	}
+	return nil
+}
+
+// commit commits the new master key register of the crypto units. All crypto units must hold the same new master
+// key. Crypto units whose new master key is already committed are skipped.
+func (c *hpcsMasterKeyCeremony) commit(units []hpcsCryptoUnit) error {
+	var mkvp []byte
+	for _, unit := range units {
+		if unit.info.NewMKStatus == ep11cmds.MK_STATUS_EMPTY {
+			return fmt.Errorf("[ERROR] The new master key register of crypto unit %s is empty. Run the load step first", unit.domain.Hsm_id)
+		}
+		if mkvp == nil {
+			mkvp = unit.info.NewMKVP
+		} else if !bytes.Equal(mkvp, unit.info.NewMKVP) {
+			return fmt.Errorf("[ERROR] The crypto units hold different new master keys (%x and %x), the new master key is not committed", mkvp, unit.info.NewMKVP)
+		}
+	}
+
+	for _, unit := range units {
+		if unit.info.NewMKStatus != ep11cmds.NMK_STATUS_FULL_UNCOMMITTED {
+			continue
+		}
+		sigkeys, sigkeySkis, sigkeyTokens, err := c.signatureKeys(unit, unit.signatureThreshold)
+		if err != nil {
+			return err
+		}
+		log.Printf("[INFO] Committing new master key in crypto unit %s", unit.domain.Hsm_id)
+		err = ep11cmds.CommitPendingWK(c.authToken, c.urlStart, unit.domain, sigkeys, sigkeySkis, sigkeyTokens)
+		if err != nil {
+			return fmt.Errorf("[ERROR] Error Committing New Master Key in Crypto Unit %s: %s", unit.domain.Hsm_id, err)
+		}
+	}
+	return nil
+}
+
+// setImmediate moves the committed new master key to the current master key register of the crypto units. The
+// recovery crypto units are processed last so the new master key can still be recovered if an operational crypto
+// unit fails. Crypto units whose new master key register is empty are skipped.
+func (c *hpcsMasterKeyCeremony) setImmediate(units []hpcsCryptoUnit) error {
+	for _, unit := range units {
+		if unit.info.NewMKStatus == ep11cmds.NMK_STATUS_FULL_UNCOMMITTED {
+			return fmt.Errorf("[ERROR] The new master key of crypto unit %s is not committed. Run the commit step first", unit.domain.Hsm_id)
+		}
+	}
+
+	ordered := make([]hpcsCryptoUnit, 0, len(units))
+	for _, unit := range units {
+		if !unit.isRecovery() {
+			ordered = append(ordered, unit)
+		}
+	}
+	for _, unit := range units {
+		if unit.isRecovery() {
+			ordered = append(ordered, unit)
+		}
+	}
+
+	for _, unit := range ordered {
+		if unit.info.NewMKStatus != ep11cmds.NMK_STATUS_FULL_COMMITTED {
+			continue
+		}
+		sigkeys, sigkeySkis, sigkeyTokens, err := c.signatureKeys(unit, 1)
+		if err != nil {
+			return err
+		}
+		log.Printf("[INFO] Setting new master key immediate in crypto unit %s", unit.domain.Hsm_id)
+		err = ep11cmds.FinalizeWK(c.authToken, c.urlStart, unit.domain, sigkeys, sigkeySkis, sigkeyTokens)
+		if err != nil {
+			return fmt.Errorf("[ERROR] Error Setting New Master Key Immediate in Crypto Unit %s: %s", unit.domain.Hsm_id, err)
+		}
+	}
+	return nil
+}
+
+// run runs the steps after the step that completed last up to and including the target step. The step attribute is
+// set after each step, so a ceremony that fails is resumed from the failed step.
+func (c *hpcsMasterKeyCeremony) run(d *schema.ResourceData, target string) error {
+	for i := hpcsMasterKeyStepIndex(d.Get("step").(string)) + 1; i <= hpcsMasterKeyStepIndex(target); i++ {
+		// The registers are queried before each step because the previous step changed them
+		units, err := c.cryptoUnits()
+		if err != nil {
+			return err
+		}
+		step := hpcsMasterKeySteps[i]
+		switch step {
+		case hpcsMasterKeyStepLoad:
+			err = c.load(units)
+		case hpcsMasterKeyStepCommit:
+			err = c.commit(units)
+		case hpcsMasterKeyStepSetImmediate:
+			err = c.setImmediate(units)
+		}
+		if err != nil {
+			return err
+		}
+		d.Set("step", step)
+		if step == hpcsMasterKeyStepSetImmediate {
+			d.Set("completed_at", time.Now().UTC().Format(time.RFC3339))
+		}
+	}
+	return nil
+}
+
+func resourceIBMHPCSMasterKeyRotationCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	unlock, err := lockTKESignServURL(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	defer unlock()
+
+	ceremony, err := newHPCSMasterKeyCeremony(d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(d.Get("instance_id").(string))
+	d.Set("started_at", time.Now().UTC().Format(time.RFC3339))
+
+	err = ceremony.run(d, d.Get("target_step").(string))
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error Rotating Master Key of HPCS instance %s: %s", d.Id(), err))
+	}
+
+	return resourceIBMHPCSMasterKeyRotationRead(context, d, meta)
+}
+
+func resourceIBMHPCSMasterKeyRotationRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	rsConClient, err := meta.(conns.ClientSession).ResourceControllerV2API()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	instanceID := d.Id()
+	instance, resp, err := rsConClient.GetResourceInstance(&rc.GetResourceInstanceOptions{
+		ID: &instanceID,
+	})
+	if err != nil || instance == nil {
+		if resp != nil && resp.StatusCode == 404 {
+			log.Printf("[WARN] Removing master key rotation (%s) from state because the HPCS instance was not found", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(fmt.Errorf("[ERROR] Error retrieving HPCS instance: %s with resp code: %s", err, resp))
+	}
+	if instance.State != nil && (strings.Contains(*instance.State, "removed") || strings.Contains(*instance.State, resourcecontroller.RsInstanceReclamation)) {
+		log.Printf("[WARN] Removing master key rotation (%s) from state because the HPCS instance is in removed or pending_reclamation state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	ci, err := hsmClient(d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	ci.InstanceId = instanceID
+
+	hsmInfo, err := tkesdk.Query(ci)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error Quering HSM config: %s", err))
+	}
+	d.Set("instance_id", instanceID)
+	d.Set("hsm_info", FlattenHSMInfo(hsmInfo))
+	if len(hsmInfo) > 0 {
+		d.Set("new_mkvp", hsmInfo[0].NewMKVP)
+		d.Set("current_mkvp", hsmInfo[0].CurrentMKVP)
+	}
+
+	return nil
+}
+
+func resourceIBMHPCSMasterKeyRotationUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if d.HasChange("target_step") {
+		unlock, err := lockTKESignServURL(d)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		defer unlock()
+
+		ceremony, err := newHPCSMasterKeyCeremony(d, meta)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		err = ceremony.run(d, d.Get("target_step").(string))
+		if err != nil {
+			return diag.FromErr(fmt.Errorf("[ERROR] Error Rotating Master Key of HPCS instance %s: %s", d.Id(), err))
+		}
+	}
+
+	return resourceIBMHPCSMasterKeyRotationRead(context, d, meta)
+}
+
+func resourceIBMHPCSMasterKeyRotationDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	// A master key can't be rotated back, so the rotation is only removed from state
+	if d.Get("step").(string) != hpcsMasterKeyStepSetImmediate {
+		log.Printf("[WARN] Master key rotation (%s) is removed from state after step %q, the new master key registers of the crypto units are left as they are", d.Id(), d.Get("step").(string))
+	}
+	d.SetId("")
+
+	return nil
+}
@@ -0,0 +1,84 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package hpcs_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIBMHPCSMasterKeyRotationBasic(t *testing.T) {
+	testName := fmt.Sprintf("tf-hpcs-%d", acctest.RandIntRange(10, 100))
+	name := "ibm_hpcs_master_key_rotation.rotation"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheckHPCS(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckIBMHPCSInstanceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMHPCSMasterKeyRotation(testName, "load"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "step", "load"),
+					resource.TestCheckResourceAttrSet(name, "new_mkvp"),
+					resource.TestCheckResourceAttrSet(name, "started_at"),
+					resource.TestCheckResourceAttr(name, "hsm_info.0.new_mk_status", "Full Uncommitted"),
+				),
+			},
+			{
+				Config: testAccCheckIBMHPCSMasterKeyRotation(testName, "commit"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "step", "commit"),
+					resource.TestCheckResourceAttr(name, "hsm_info.0.new_mk_status", "Full Committed"),
+				),
+			},
+			{
+				Config: testAccCheckIBMHPCSMasterKeyRotation(testName, "set_immediate"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "step", "set_immediate"),
+					resource.TestCheckResourceAttr(name, "hsm_info.0.new_mk_status", "Empty"),
+					resource.TestCheckResourceAttr(name, "hsm_info.0.current_mk_status", "Valid"),
+					resource.TestCheckResourceAttrSet(name, "completed_at"),
+				),
+			},
+			{
+				Config:      testAccCheckIBMHPCSMasterKeyRotation(testName, "load"),
+				ExpectError: regexp.MustCompile(`target_step can't be changed to load`),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMHPCSMasterKeyRotation(name string, targetStep string) string {
+	return fmt.Sprintf(`
+	resource ibm_hpcs hpcs {
+		location             = "us-south"
+		name                 = "%s"
+		plan                 = "standard"
+		units                = 2
+		signature_threshold  = 1
+		revocation_threshold = 1
+		admins {
+			name  = "ad1"
+			key   = "%s"
+			token = "%s"
+		}
+	}
+	resource ibm_hpcs_master_key_rotation rotation {
+		instance_id = ibm_hpcs.hpcs.guid
+		location    = ibm_hpcs.hpcs.location
+		target_step = "%s"
+		admins {
+			name  = "ad1"
+			key   = "%s"
+			token = "%s"
+		}
+	}
+	`, name, acc.HpcsAdmin1, acc.HpcsToken1, targetStep, acc.HpcsAdmin1, acc.HpcsToken1)
+}
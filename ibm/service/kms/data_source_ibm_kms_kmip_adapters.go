@@ -0,0 +1,135 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package kms
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	kp "github.com/IBM/keyprotect-go-client"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// kmipListLimit is the page size used to list KMIP adapters, client certificates and objects.
+const kmipListLimit = 200
+
+func DataSourceIBMKmsKMIPAdapters() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceIBMKmsKMIPAdaptersRead,
+
+		Schema: map[string]*schema.Schema{
+			"instance_id": {
+				Type:             schema.TypeString,
+				Required:         true,
+				Description:      "Key protect instance GUID or CRN",
+				DiffSuppressFunc: suppressKMSInstanceIDDiff,
+			},
+			"endpoint_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validate.ValidateAllowedStringValues([]string{"public", "private"}),
+				Description:  "public or private",
+				Default:      "public",
+			},
+			"crk_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Lists only the KMIP adapters that use the root key with this ID",
+			},
+			"adapters": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The KMIP adapters of the instance",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"description": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"profile": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"profile_data": {
+							Type:     schema.TypeMap,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"created_at": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"created_by": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"updated_at": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"updated_by": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceIBMKmsKMIPAdaptersRead(d *schema.ResourceData, meta interface{}) error {
+	instanceID := getInstanceIDFromCRN(d.Get("instance_id").(string))
+	kpAPI, _, err := populateKPClient(d, meta, instanceID)
+	if err != nil {
+		return err
+	}
+
+	adapters, err := listKMIPAdapters(kpAPI, d.Get("crk_id").(string))
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error while listing KMIP adapters: %s", err)
+	}
+
+	adapterList := make([]map[string]interface{}, 0, len(adapters))
+	for i := range adapters {
+		adapterList = append(adapterList, flattenKMIPAdapter(&adapters[i]))
+	}
+
+	d.SetId(instanceID)
+	d.Set("instance_id", instanceID)
+	d.Set("adapters", adapterList)
+	return nil
+}
+
+// listKMIPAdapters lists all KMIP adapters of the instance, or the adapters that use the root key if crkID is set.
+func listKMIPAdapters(kpAPI *kp.Client, crkID string) ([]kp.KMIPAdapter, error) {
+	adapters := []kp.KMIPAdapter{}
+	limit := uint32(kmipListLimit)
+	for offset := uint32(0); ; offset += limit {
+		options := &kp.ListKmipAdaptersOptions{
+			Limit:  &limit,
+			Offset: &offset,
+		}
+		if crkID != "" {
+			options.CrkID = &crkID
+		}
+		page, err := kpAPI.GetKMIPAdapters(context.Background(), options)
+		if err != nil {
+			return nil, err
+		}
+		adapters = append(adapters, page.Adapters...)
+		if len(page.Adapters) < kmipListLimit {
+			return adapters, nil
+		}
+	}
+}
@@ -0,0 +1,40 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package kms_test
+
+import (
+	"fmt"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIBMKMSKMIPAdaptersDataSource_basic(t *testing.T) {
+	instanceName := fmt.Sprintf("kms_%d", acctest.RandIntRange(10, 100))
+	keyName := fmt.Sprintf("key_%d", acctest.RandIntRange(10, 100))
+	adapterName := fmt.Sprintf("kmip-adapter-%d", acctest.RandIntRange(10, 100))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMKmsKMIPAdapterConfig(instanceName, keyName, adapterName, "crk_id = ibm_kms_key.test.key_id") + `
+	data "ibm_kms_kmip_adapters" "adapters" {
+		instance_id = ibm_kms_kmip_adapter.adapter.instance_id
+		crk_id      = ibm_kms_key.test.key_id
+	}
+`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.ibm_kms_kmip_adapters.adapters", "adapters.#", "1"),
+					resource.TestCheckResourceAttrPair("data.ibm_kms_kmip_adapters.adapters", "adapters.0.id", "ibm_kms_kmip_adapter.adapter", "adapter_id"),
+					resource.TestCheckResourceAttr("data.ibm_kms_kmip_adapters.adapters", "adapters.0.name", adapterName),
+					resource.TestCheckResourceAttr("data.ibm_kms_kmip_adapters.adapters", "adapters.0.profile", "native_1.0"),
+				),
+			},
+		},
+	})
+}
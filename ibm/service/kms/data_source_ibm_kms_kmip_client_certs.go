@@ -0,0 +1,112 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package kms
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	kp "github.com/IBM/keyprotect-go-client"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func DataSourceIBMKmsKMIPClientCertificates() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceIBMKmsKMIPClientCertificatesRead,
+
+		Schema: map[string]*schema.Schema{
+			"instance_id": {
+				Type:             schema.TypeString,
+				Required:         true,
+				Description:      "Key protect instance GUID or CRN",
+				DiffSuppressFunc: suppressKMSInstanceIDDiff,
+			},
+			"endpoint_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validate.ValidateAllowedStringValues([]string{"public", "private"}),
+				Description:  "public or private",
+				Default:      "public",
+			},
+			"adapter_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The ID or name of the KMIP adapter",
+			},
+			"certificates": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The client certificates of the KMIP adapter",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"certificate": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"created_at": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"created_by": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceIBMKmsKMIPClientCertificatesRead(d *schema.ResourceData, meta interface{}) error {
+	instanceID := getInstanceIDFromCRN(d.Get("instance_id").(string))
+	kpAPI, _, err := populateKPClient(d, meta, instanceID)
+	if err != nil {
+		return err
+	}
+
+	adapterID := d.Get("adapter_id").(string)
+	certs, err := listKMIPClientCertificates(kpAPI, adapterID)
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error while listing KMIP client certificates: %s", err)
+	}
+
+	certList := make([]map[string]interface{}, 0, len(certs))
+	for _, cert := range certs {
+		certList = append(certList, flattenKMIPClientCertificate(cert))
+	}
+
+	d.SetId(fmt.Sprintf("%s:%s", instanceID, adapterID))
+	d.Set("instance_id", instanceID)
+	d.Set("certificates", certList)
+	return nil
+}
+
+// listKMIPClientCertificates lists all client certificates of the KMIP adapter.
+func listKMIPClientCertificates(kpAPI *kp.Client, adapterID string) ([]kp.KMIPClientCertificate, error) {
+	certs := []kp.KMIPClientCertificate{}
+	limit := uint32(kmipListLimit)
+	for offset := uint32(0); ; offset += limit {
+		page, err := kpAPI.GetKMIPClientCertificates(context.Background(), adapterID, &kp.ListOptions{
+			Limit:  &limit,
+			Offset: &offset,
+		})
+		if err != nil {
+			return nil, err
+		}
+		certs = append(certs, page.Certificates...)
+		if len(page.Certificates) < kmipListLimit {
+			return certs, nil
+		}
+	}
+}
@@ -0,0 +1,41 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package kms_test
+
+import (
+	"fmt"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIBMKMSKMIPClientCertsDataSource_basic(t *testing.T) {
+	instanceName := fmt.Sprintf("kms_%d", acctest.RandIntRange(10, 100))
+	keyName := fmt.Sprintf("key_%d", acctest.RandIntRange(10, 100))
+	adapterName := fmt.Sprintf("kmip-adapter-%d", acctest.RandIntRange(10, 100))
+	certName := fmt.Sprintf("kmip-cert-%d", acctest.RandIntRange(10, 100))
+	certificate := testAccKMIPClientCertificate(t)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMKmsKMIPClientCertConfig(instanceName, keyName, adapterName, certName, certificate) + `
+	data "ibm_kms_kmip_client_certs" "certs" {
+		instance_id = ibm_kms_kmip_client_cert.cert.instance_id
+		adapter_id  = ibm_kms_kmip_client_cert.cert.adapter_id
+	}
+`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.ibm_kms_kmip_client_certs.certs", "certificates.#", "1"),
+					resource.TestCheckResourceAttrPair("data.ibm_kms_kmip_client_certs.certs", "certificates.0.id", "ibm_kms_kmip_client_cert.cert", "cert_id"),
+					resource.TestCheckResourceAttr("data.ibm_kms_kmip_client_certs.certs", "certificates.0.name", certName),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,181 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package kms
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	kp "github.com/IBM/keyprotect-go-client"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func DataSourceIBMKmsKMIPObjects() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceIBMKmsKMIPObjectsRead,
+
+		Schema: map[string]*schema.Schema{
+			"instance_id": {
+				Type:             schema.TypeString,
+				Required:         true,
+				Description:      "Key protect instance GUID or CRN",
+				DiffSuppressFunc: suppressKMSInstanceIDDiff,
+			},
+			"endpoint_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validate.ValidateAllowedStringValues([]string{"public", "private"}),
+				Description:  "public or private",
+				Default:      "public",
+			},
+			"adapter_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The ID or name of the KMIP adapter",
+			},
+			"object_state_filter": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeInt, ValidateFunc: validation.IntBetween(1, 6)},
+				Description: "Lists only the KMIP objects in these states. By default, the objects in the Pre-Active, Active, Deactivated and Compromised states are listed",
+			},
+			"objects": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The KMIP objects of the KMIP adapter",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"kmip_object_type": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"state": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"created_by_cert_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"created_by": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"created_at": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"updated_by_cert_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"updated_by": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"updated_at": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"destroyed_by_cert_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"destroyed_by": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"destroyed_at": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceIBMKmsKMIPObjectsRead(d *schema.ResourceData, meta interface{}) error {
+	instanceID := getInstanceIDFromCRN(d.Get("instance_id").(string))
+	kpAPI, _, err := populateKPClient(d, meta, instanceID)
+	if err != nil {
+		return err
+	}
+
+	var states []int32
+	for _, state := range d.Get("object_state_filter").([]interface{}) {
+		states = append(states, int32(state.(int)))
+	}
+
+	adapterID := d.Get("adapter_id").(string)
+	objects, err := listKMIPObjects(kpAPI, adapterID, states)
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error while listing KMIP objects: %s", err)
+	}
+
+	objectList := make([]map[string]interface{}, 0, len(objects))
+	for _, object := range objects {
+		objectList = append(objectList, flattenKMIPObject(object))
+	}
+
+	d.SetId(fmt.Sprintf("%s:%s", instanceID, adapterID))
+	d.Set("instance_id", instanceID)
+	d.Set("objects", objectList)
+	return nil
+}
+
+// listKMIPObjects lists all KMIP objects of the KMIP adapter, or the objects in the states if states are set.
+func listKMIPObjects(kpAPI *kp.Client, adapterID string, states []int32) ([]kp.KMIPObject, error) {
+	objects := []kp.KMIPObject{}
+	limit := uint32(kmipListLimit)
+	for offset := uint32(0); ; offset += limit {
+		options := &kp.ListKmipObjectsOptions{
+			Limit:  &limit,
+			Offset: &offset,
+		}
+		if len(states) > 0 {
+			options.ObjectStateFilter = &states
+		}
+		page, err := kpAPI.GetKMIPObjects(context.Background(), adapterID, options)
+		if err != nil {
+			return nil, err
+		}
+		objects = append(objects, page.Objects...)
+		if len(page.Objects) < kmipListLimit {
+			return objects, nil
+		}
+	}
+}
+
+func flattenKMIPObject(object kp.KMIPObject) map[string]interface{} {
+	flattened := map[string]interface{}{
+		"id":                   object.ID,
+		"kmip_object_type":     object.KMIPObjectType,
+		"state":                object.ObjectState,
+		"created_by_cert_id":   object.CreatedByCertID,
+		"created_by":           object.CreatedBy,
+		"updated_by_cert_id":   object.UpdatedByCertID,
+		"updated_by":           object.UpdatedBy,
+		"destroyed_by_cert_id": object.DestroyedByCertID,
+		"destroyed_by":         object.DestroyedBy,
+	}
+	if object.CreatedAt != nil {
+		flattened["created_at"] = object.CreatedAt.Format(time.RFC3339)
+	}
+	if object.UpdatedAt != nil {
+		flattened["updated_at"] = object.UpdatedAt.Format(time.RFC3339)
+	}
+	if object.DestroyedAt != nil {
+		flattened["destroyed_at"] = object.DestroyedAt.Format(time.RFC3339)
+	}
+	return flattened
+}
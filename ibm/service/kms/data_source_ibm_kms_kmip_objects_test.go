@@ -0,0 +1,39 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package kms_test
+
+import (
+	"fmt"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIBMKMSKMIPObjectsDataSource_basic(t *testing.T) {
+	instanceName := fmt.Sprintf("kms_%d", acctest.RandIntRange(10, 100))
+	keyName := fmt.Sprintf("key_%d", acctest.RandIntRange(10, 100))
+	adapterName := fmt.Sprintf("kmip-adapter-%d", acctest.RandIntRange(10, 100))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				// KMIP objects are created by KMIP clients, a new adapter has none
+				Config: testAccCheckIBMKmsKMIPAdapterConfig(instanceName, keyName, adapterName, "crk_id = ibm_kms_key.test.key_id") + `
+	data "ibm_kms_kmip_objects" "objects" {
+		instance_id         = ibm_kms_kmip_adapter.adapter.instance_id
+		adapter_id          = ibm_kms_kmip_adapter.adapter.adapter_id
+		object_state_filter = [1, 2, 3, 4, 5, 6]
+	}
+`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.ibm_kms_kmip_objects.objects", "objects.#", "0"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,176 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package kms
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	kp "github.com/IBM/keyprotect-go-client"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func ResourceIBMKmsImportToken() *schema.Resource {
+	return &schema.Resource{
+		Create:   resourceIBMKmsImportTokenCreate,
+		Read:     resourceIBMKmsImportTokenRead,
+		Delete:   resourceIBMKmsImportTokenDelete,
+		Importer: &schema.ResourceImporter{},
+
+		Schema: map[string]*schema.Schema{
+			"instance_id": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				Description:      "Key protect or hpcs instance GUID or CRN",
+				DiffSuppressFunc: suppressKMSInstanceIDDiff,
+			},
+			"endpoint_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.ValidateAllowedStringValues([]string{"public", "private"}),
+				Description:  "public or private",
+			},
+			"expiration": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      600,
+				ValidateFunc: validation.IntBetween(300, 86400),
+				Description:  "Number of seconds the import token is valid",
+			},
+			"max_allowed_retrievals": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      1,
+				ValidateFunc: validation.IntBetween(1, 500),
+				Description:  "Number of times the import token can be retrieved within its expiration time",
+			},
+			"creation_date": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The date the import token was created",
+			},
+			"expiration_date": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The date the import token expires",
+			},
+		},
+	}
+}
+
+func resourceIBMKmsImportTokenCreate(d *schema.ResourceData, meta interface{}) error {
+	instanceID := getInstanceIDFromCRN(d.Get("instance_id").(string))
+	kpAPI, instanceCRN, err := populateKPClient(d, meta, instanceID)
+	if err != nil {
+		return err
+	}
+
+	token, err := kpAPI.CreateImportToken(context.Background(), d.Get("expiration").(int), d.Get("max_allowed_retrievals").(int))
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error while creating import token: %s", err)
+	}
+
+	d.SetId(fmt.Sprintf("importToken:%s", *instanceCRN))
+	if token.CreationDate != nil {
+		d.Set("creation_date", token.CreationDate.Format(time.RFC3339))
+	}
+	if token.ExpirationDate != nil {
+		d.Set("expiration_date", token.ExpirationDate.Format(time.RFC3339))
+	}
+
+	return resourceIBMKmsImportTokenRead(d, meta)
+}
+
+func resourceIBMKmsImportTokenRead(d *schema.ResourceData, meta interface{}) error {
+	instanceCRN := strings.TrimPrefix(d.Id(), "importToken:")
+	if instanceCRN == d.Id() {
+		return fmt.Errorf("[ERROR] Incorrect ID %s: Id should be a combination of importToken:InstanceCRN", d.Id())
+	}
+	instanceID := getInstanceIDFromCRN(instanceCRN)
+	kpAPI, _, err := populateKPClient(d, meta, instanceID)
+	if err != nil {
+		return err
+	}
+
+	// Retrieving the import token counts against its retrievals, so only the instance is read.
+	d.Set("instance_id", instanceID)
+	if strings.Contains((kpAPI.URL).String(), "private") || strings.Contains(kpAPI.Config.BaseURL, "private") {
+		d.Set("endpoint_type", "private")
+	} else {
+		d.Set("endpoint_type", "public")
+	}
+	return nil
+}
+
+func resourceIBMKmsImportTokenDelete(d *schema.ResourceData, meta interface{}) error {
+	// Import tokens can't be deleted, they expire or are replaced by the next import token of the instance.
+	log.Printf("[WARN] Import token (%s) is removed from state only, it is deleted when it expires", d.Id())
+	d.SetId("")
+	return nil
+}
+
+// wrapKeyMaterial retrieves the import token of the instance and wraps the key material with its transport key.
+// It returns the encrypted key material, encrypted nonce and IV to import a root key. An import token is created
+// if the instance has no import token that can be retrieved.
+func wrapKeyMaterial(kpAPI *kp.Client, keyMaterial string, hpcs bool) (payload, encryptedNonce, iv string, err error) {
+	token, err := kpAPI.GetImportTokenTransportKey(context.Background())
+	if err != nil {
+		log.Printf("[DEBUG] Creating import token because no import token could be retrieved: %s", err)
+		_, err = kpAPI.CreateImportToken(context.Background(), 600, 1)
+		if err != nil {
+			return "", "", "", fmt.Errorf("[ERROR] Error while creating import token: %s", err)
+		}
+		token, err = kpAPI.GetImportTokenTransportKey(context.Background())
+		if err != nil {
+			return "", "", "", fmt.Errorf("[ERROR] Error while retrieving import token: %s", err)
+		}
+	}
+
+	// Hyper Protect Crypto Services only supports SHA-1 for RSA-OAEP and AES-CBC-PAD for the nonce
+	if hpcs {
+		payload, err = kp.EncryptKeyWithSHA1(keyMaterial, token.Payload)
+	} else {
+		payload, err = kp.EncryptKey(keyMaterial, token.Payload)
+	}
+	if err != nil {
+		return "", "", "", fmt.Errorf("[ERROR] Error while wrapping key material: %s", err)
+	}
+
+	if hpcs {
+		encryptedNonce, iv, err = kp.EncryptNonceWithCBCPAD(keyMaterial, token.Nonce, "")
+	} else {
+		encryptedNonce, iv, err = kp.EncryptNonce(keyMaterial, token.Nonce, "")
+	}
+	if err != nil {
+		return "", "", "", fmt.Errorf("[ERROR] Error while encrypting import token nonce: %s", err)
+	}
+
+	return payload, encryptedNonce, iv, nil
+}
+
+// validateKeyMaterial checks that the key material is a base64 encoded 128, 192 or 256 bit key.
+func validateKeyMaterial(v interface{}, k string) (ws []string, errors []error) {
+	keyMaterial, err := base64.StdEncoding.DecodeString(v.(string))
+	if err != nil {
+		errors = append(errors, fmt.Errorf("%q must be base64 encoded: %s", k, err))
+		return
+	}
+	switch len(keyMaterial) {
+	case 16, 24, 32:
+	default:
+		errors = append(errors, fmt.Errorf("%q must be a 128, 192 or 256 bit key, got %d bits", k, len(keyMaterial)*8))
+	}
+	return
+}
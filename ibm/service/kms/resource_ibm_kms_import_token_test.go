@@ -0,0 +1,76 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package kms_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIBMKMSImportToken_basic(t *testing.T) {
+	instanceName := fmt.Sprintf("kms_%d", acctest.RandIntRange(10, 100))
+	keyName := fmt.Sprintf("key_%d", acctest.RandIntRange(10, 100))
+	keyMaterial := "LqMWNtSi3Snr4gFNO0PsFFLFRNs57mSXCQE7O2oE+g0="
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMKmsImportTokenConfig(instanceName, keyName, keyMaterial),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_kms_import_token.token", "expiration", "1200"),
+					resource.TestCheckResourceAttr("ibm_kms_import_token.token", "max_allowed_retrievals", "1"),
+					resource.TestCheckResourceAttrSet("ibm_kms_import_token.token", "expiration_date"),
+					resource.TestCheckResourceAttr("ibm_kms_key.test", "key_name", keyName),
+					resource.TestCheckResourceAttr("ibm_kms_key.test", "standard_key", "false"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccIBMKMSImportToken_InvalidKeyMaterial(t *testing.T) {
+	instanceName := fmt.Sprintf("kms_%d", acctest.RandIntRange(10, 100))
+	keyName := fmt.Sprintf("key_%d", acctest.RandIntRange(10, 100))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccCheckIBMKmsImportTokenConfig(instanceName, keyName, "aW52YWxpZA=="),
+				ExpectError: regexp.MustCompile("must be a 128, 192 or 256 bit key"),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMKmsImportTokenConfig(instanceName, keyName, keyMaterial string) string {
+	return fmt.Sprintf(`
+	resource "ibm_resource_instance" "kms_instance" {
+		name              = "%s"
+		service           = "kms"
+		plan              = "tiered-pricing"
+		location          = "us-south"
+	}
+	resource "ibm_kms_import_token" "token" {
+		instance_id            = ibm_resource_instance.kms_instance.guid
+		expiration             = 1200
+		max_allowed_retrievals = 1
+	}
+	resource "ibm_kms_key" "test" {
+		instance_id  = ibm_kms_import_token.token.instance_id
+		key_name     = "%s"
+		standard_key = false
+		key_material = "%s"
+		force_delete = true
+	}
+`, instanceName, keyName, keyMaterial)
+}
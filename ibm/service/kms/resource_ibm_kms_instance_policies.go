@@ -11,6 +11,7 @@ import (
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	"github.com/IBM/go-sdk-core/v5/core"
 	kp "github.com/IBM/keyprotect-go-client"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -317,13 +318,16 @@ func policyCreateOrUpdate(context context.Context, d *schema.ResourceData, kpAPI
 	if kciaip, ok := d.GetOk("key_create_import_access"); ok {
 		kciaipList := kciaip.([]interface{})
 		if len(kciaipList) != 0 {
+			kciaipMap := kciaipList[0].(map[string]interface{})
 			mulPolicy.KeyCreateImportAccess = &kp.KeyCreateImportAccessInstancePolicy{
-				Enabled:           kciaipList[0].(map[string]interface{})["enabled"].(bool),
-				CreateRootKey:     kciaipList[0].(map[string]interface{})["create_root_key"].(bool),
-				CreateStandardKey: kciaipList[0].(map[string]interface{})["create_standard_key"].(bool),
-				ImportRootKey:     kciaipList[0].(map[string]interface{})["import_root_key"].(bool),
-				ImportStandardKey: kciaipList[0].(map[string]interface{})["import_standard_key"].(bool),
-				EnforceToken:      kciaipList[0].(map[string]interface{})["enforce_token"].(bool),
+				Enabled: kciaipMap["enabled"].(bool),
+				Attributes: &kp.KeyCreateImportAccessInstancePolicyAttributes{
+					CreateRootKey:     core.BoolPtr(kciaipMap["create_root_key"].(bool)),
+					CreateStandardKey: core.BoolPtr(kciaipMap["create_standard_key"].(bool)),
+					ImportRootKey:     core.BoolPtr(kciaipMap["import_root_key"].(bool)),
+					ImportStandardKey: core.BoolPtr(kciaipMap["import_standard_key"].(bool)),
+					EnforceToken:      core.BoolPtr(kciaipMap["enforce_token"].(bool)),
+				},
 			}
 		}
 	}
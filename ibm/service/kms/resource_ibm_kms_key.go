@@ -35,12 +35,13 @@ func getInstanceIDFromCRN(crn string) string {
 
 func ResourceIBMKmskey() *schema.Resource {
 	return &schema.Resource{
-		Create:   resourceIBMKmsKeyCreate,
-		Read:     resourceIBMKmsKeyRead,
-		Update:   resourceIBMKmsKeyUpdate,
-		Delete:   resourceIBMKmsKeyDelete,
-		Exists:   resourceIBMKmsKeyExists,
-		Importer: &schema.ResourceImporter{},
+		Create:        resourceIBMKmsKeyCreate,
+		Read:          resourceIBMKmsKeyRead,
+		Update:        resourceIBMKmsKeyUpdate,
+		Delete:        resourceIBMKmsKeyDelete,
+		Exists:        resourceIBMKmsKeyExists,
+		CustomizeDiff: resourceIBMKmsKeyDiff,
+		Importer:      &schema.ResourceImporter{},
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(10 * time.Minute),
 			Update: schema.DefaultTimeout(10 * time.Minute),
@@ -107,15 +108,26 @@ func ResourceIBMKmskey() *schema.Resource {
 			"encrypted_nonce": {
 				Type:        schema.TypeString,
 				Optional:    true,
+				Computed:    true,
 				ForceNew:    true,
 				Description: "Only for imported root key",
 			},
 			"iv_value": {
 				Type:        schema.TypeString,
 				Optional:    true,
+				Computed:    true,
 				ForceNew:    true,
 				Description: "Only for imported root key",
 			},
+			"key_material": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Sensitive:     true,
+				ForceNew:      true,
+				ConflictsWith: []string{"payload", "encrypted_nonce", "iv_value"},
+				ValidateFunc:  validateKeyMaterial,
+				Description:   "Base64 encoded key material of an imported root key. The key material is wrapped with the import token of the instance",
+			},
 			"force_delete": {
 				Type:        schema.TypeBool,
 				Optional:    true,
@@ -128,6 +140,13 @@ func ResourceIBMKmskey() *schema.Resource {
 				Computed:    true,
 				Description: "Crn of the key",
 			},
+			"key_state": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validate.ValidateAllowedStringValues([]string{kmsKeyStateActive, kmsKeyStateSuspended}),
+				Description:  "The state of the key. Set to suspended to disable a root key, or to active to enable it",
+			},
 			"expiration_date": {
 				Type:        schema.TypeString,
 				Optional:    true,
@@ -176,16 +195,29 @@ func resourceIBMKmsKeyCreate(d *schema.ResourceData, meta interface{}) error {
 	if err != nil {
 		return err
 	}
-	kpAPI, _, err := populateKPClient(d, meta, instanceID)
+	kpAPI, instanceCRN, err := populateKPClient(d, meta, instanceID)
 	if err != nil {
 		return err
 	}
 
 	kpAPI.Config.KeyRing = d.Get("key_ring_id").(string)
 
+	sha1 := false
+	if keyMaterial, ok := d.GetOk("key_material"); ok {
+		if keyData.Extractable {
+			return fmt.Errorf("[ERROR] key_material is only supported for root keys, use payload for standard keys")
+		}
+		// Hyper Protect Crypto Services instances wrap the key material differently
+		sha1 = strings.Split(*instanceCRN, ":")[4] == "hs-crypto"
+		keyData.Payload, keyData.EncryptedNonce, keyData.IV, err = wrapKeyMaterial(kpAPI, keyMaterial.(string), sha1)
+		if err != nil {
+			return err
+		}
+	}
+
 	key, err := kpAPI.CreateKeyWithOptions(context.Background(), keyData.Name, keyData.Extractable,
 		kp.WithExpiration(keyData.Expiration),
-		kp.WithPayload(keyData.Payload, &keyData.EncryptedNonce, &keyData.IV, false),
+		kp.WithPayload(keyData.Payload, &keyData.EncryptedNonce, &keyData.IV, sha1),
 		kp.WithDescription(keyData.Description))
 	if err != nil {
 		return fmt.Errorf("[ERROR] Error while creating key: %s", err)
@@ -202,11 +234,44 @@ func resourceIBMKmsKeyRead(d *schema.ResourceData, meta interface{}) error {
 
 }
 
+func resourceIBMKmsKeyDiff(context context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if !diff.HasChange("key_state") {
+		return nil
+	}
+	oldState, newState := diff.GetChange("key_state")
+	if newState.(string) == "" {
+		return nil
+	}
+	if newState.(string) == kmsKeyStateSuspended && diff.Get("standard_key").(bool) {
+		return fmt.Errorf("[ERROR] key_state %s is only supported for root keys", kmsKeyStateSuspended)
+	}
+	// The key is created in the active state
+	if diff.Id() == "" {
+		return nil
+	}
+	return validateKMSKeyStateTransition(oldState.(string), newState.(string))
+}
+
 func resourceIBMKmsKeyUpdate(d *schema.ResourceData, meta interface{}) error {
 
 	if d.HasChange("force_delete") {
 		d.Set("force_delete", d.Get("force_delete").(bool))
 	}
+	if d.HasChange("key_state") {
+		timeout := d.Timeout(schema.TimeoutUpdate)
+		if d.IsNewResource() {
+			timeout = d.Timeout(schema.TimeoutCreate)
+		}
+		_, instanceID, keyid := getInstanceAndKeyDataFromCRN(d.Id())
+		kpAPI, _, err := populateKPClient(d, meta, instanceID)
+		if err != nil {
+			return err
+		}
+		err = setKMSKeyState(kpAPI, keyid, d.Get("key_state").(string), timeout)
+		if err != nil {
+			return err
+		}
+	}
 	return resourceIBMKmsKeyRead(d, meta)
 
 }
@@ -254,16 +319,22 @@ func resourceIBMKmsKeyExists(d *schema.ResourceData, meta interface{}) (bool, er
 
 // Populate KP Client using info from schema
 func populateKPClient(d *schema.ResourceData, meta interface{}, instanceID string) (kpAPI *kp.Client, instanceCRN *string, err error) {
-	kpAPI, err = meta.(conns.ClientSession).KeyManagementAPI()
-	if err != nil {
-		return nil, nil, err
-	}
 	var endpointType string
 
 	if v, ok := d.GetOk("endpoint_type"); ok {
 		endpointType = v.(string)
 	}
 
+	return populateKPClientWithEndpoint(meta, instanceID, endpointType)
+}
+
+// Populate KP Client for the instance and endpoint type
+func populateKPClientWithEndpoint(meta interface{}, instanceID, endpointType string) (kpAPI *kp.Client, instanceCRN *string, err error) {
+	kpAPI, err = meta.(conns.ClientSession).KeyManagementAPI()
+	if err != nil {
+		return nil, nil, err
+	}
+
 	rsConClient, err := meta.(conns.ClientSession).ResourceControllerV2API()
 	if err != nil {
 		return nil, nil, err
@@ -318,6 +389,7 @@ func setKeyDetails(d *schema.ResourceData, meta interface{}, instanceID string,
 	d.Set(flex.ResourceCRN, key.CRN)
 	state := key.State
 	d.Set(flex.ResourceStatus, strconv.Itoa(state))
+	d.Set("key_state", kmsKeyStateName(state))
 	rcontroller, err := flex.GetBaseController(meta)
 	if err != nil {
 		return err
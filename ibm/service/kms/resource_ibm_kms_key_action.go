@@ -0,0 +1,513 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package kms
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	kp "github.com/IBM/keyprotect-go-client"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// https://cloud.ibm.com/docs/key-protect?topic=key-protect-key-states
+const (
+	kmsKeyStatePreActivation = "pre_activation"
+	kmsKeyStateActive        = "active"
+	kmsKeyStateSuspended     = "suspended"
+	kmsKeyStateDeactivated   = "deactivated"
+	kmsKeyStateDestroyed     = "destroyed"
+)
+
+const (
+	kmsKeyActionRotate        = "rotate"
+	kmsKeyActionDisable       = "disable"
+	kmsKeyActionEnable        = "enable"
+	kmsKeyActionSetDeletion   = "set_deletion"
+	kmsKeyActionUnsetDeletion = "unset_deletion"
+	kmsKeyActionRestore       = "restore"
+)
+
+// kmsKeyActionStates lists the key states in which an action can be performed.
+var kmsKeyActionStates = map[string][]string{
+	kmsKeyActionRotate:        {kmsKeyStateActive},
+	kmsKeyActionDisable:       {kmsKeyStateActive},
+	kmsKeyActionEnable:        {kmsKeyStateSuspended},
+	kmsKeyActionSetDeletion:   {kmsKeyStateActive, kmsKeyStateSuspended},
+	kmsKeyActionUnsetDeletion: {kmsKeyStateActive, kmsKeyStateSuspended},
+	kmsKeyActionRestore:       {kmsKeyStateDestroyed},
+}
+
+func ResourceIBMKmsKeyAction() *schema.Resource {
+	return &schema.Resource{
+		Create:        resourceIBMKmsKeyActionCreate,
+		Read:          resourceIBMKmsKeyActionRead,
+		Delete:        resourceIBMKmsKeyActionDelete,
+		CustomizeDiff: resourceIBMKmsKeyActionDiff,
+		Importer:      &schema.ResourceImporter{},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"instance_id": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				Description:      "Key protect or hpcs instance GUID or CRN",
+				DiffSuppressFunc: suppressKMSInstanceIDDiff,
+			},
+			"endpoint_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.ValidateAllowedStringValues([]string{"public", "private"}),
+				Description:  "public or private",
+			},
+			"key_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "ID or alias of the key",
+			},
+			"action": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validate.ValidateAllowedStringValues([]string{kmsKeyActionRotate, kmsKeyActionDisable, kmsKeyActionEnable,
+					kmsKeyActionSetDeletion, kmsKeyActionUnsetDeletion, kmsKeyActionRestore}),
+				Description: "The action to perform on the key",
+			},
+			"payload": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Sensitive:     true,
+				ForceNew:      true,
+				ConflictsWith: []string{"key_material"},
+				Description:   "Base64 encoded key material to rotate or restore an imported key with. Only for the rotate and restore actions",
+			},
+			"key_material": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Sensitive:     true,
+				ForceNew:      true,
+				ConflictsWith: []string{"payload"},
+				ValidateFunc:  validateKeyMaterial,
+				Description:   "Base64 encoded key material to rotate or restore an imported key with, wrapped with the import token of the instance. Only for the rotate and restore actions",
+			},
+			"triggers": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				ForceNew:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Arbitrary values that, when changed, perform the action again",
+			},
+			"crn": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Crn of the key",
+			},
+			"key_state": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The state of the key",
+			},
+			"performed_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The date the action was performed",
+			},
+		},
+	}
+}
+
+// kmsKeyStateName returns the name of the numeric state of a key.
+func kmsKeyStateName(state int) string {
+	switch kp.KeyState(state) {
+	case kp.Active:
+		return kmsKeyStateActive
+	case kp.Suspended:
+		return kmsKeyStateSuspended
+	case kp.Deactivated:
+		return kmsKeyStateDeactivated
+	case kp.Destroyed:
+		return kmsKeyStateDestroyed
+	}
+	return kmsKeyStatePreActivation
+}
+
+// validateKMSKeyAction checks that the action can be performed on the key in its current state. withKeyMaterial
+// tells whether key material is given with payload or key_material.
+func validateKMSKeyAction(action string, key *kp.Key, withKeyMaterial bool) error {
+	state := kmsKeyStateName(key.State)
+	allowed := kmsKeyActionStates[action]
+	if !containsKMSKeyState(allowed, state) {
+		return fmt.Errorf("[ERROR] Action %s can't be performed on key %s in the %s state, the key must be %s", action, key.ID, state, strings.Join(allowed, " or "))
+	}
+
+	switch action {
+	case kmsKeyActionRotate, kmsKeyActionDisable:
+		if key.Extractable {
+			return fmt.Errorf("[ERROR] Action %s can only be performed on root keys", action)
+		}
+	case kmsKeyActionSetDeletion, kmsKeyActionUnsetDeletion:
+		if key.DualAuthDelete != nil && key.DualAuthDelete.Enabled != nil && !*key.DualAuthDelete.Enabled {
+			return fmt.Errorf("[ERROR] Action %s requires a dual authorization delete policy on key %s, set it with the ibm_kms_key_policies resource", action, key.ID)
+		}
+	case kmsKeyActionRestore:
+		if key.Imported && !withKeyMaterial {
+			return fmt.Errorf("[ERROR] Key %s was imported and can only be restored with its key material, set key_material or payload", key.ID)
+		}
+		if !key.Imported && withKeyMaterial {
+			return fmt.Errorf("[ERROR] Key %s was not imported and is restored without key material, remove key_material and payload", key.ID)
+		}
+	}
+	return nil
+}
+
+// validateKMSKeyStateTransition checks that a key can move from one state to the other by enabling or disabling it.
+func validateKMSKeyStateTransition(from, to string) error {
+	if from == to {
+		return nil
+	}
+	action := kmsKeyActionEnable
+	if to == kmsKeyStateSuspended {
+		action = kmsKeyActionDisable
+	}
+	if !containsKMSKeyState(kmsKeyActionStates[action], from) {
+		return fmt.Errorf("[ERROR] key_state can't change from %s to %s", from, to)
+	}
+	return nil
+}
+
+func containsKMSKeyState(states []string, state string) bool {
+	for _, s := range states {
+		if s == state {
+			return true
+		}
+	}
+	return false
+}
+
+// setKMSKeyState enables or disables the key, unless it's already in the state, and waits for the state.
+func setKMSKeyState(kpAPI *kp.Client, keyID, state string, timeout time.Duration) error {
+	key, err := kpAPI.GetKeyMetadata(context.Background(), keyID)
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error while retrieving key: %s", err)
+	}
+	if kmsKeyStateName(key.State) == state {
+		return nil
+	}
+
+	if state == kmsKeyStateSuspended {
+		err = kpAPI.DisableKey(context.Background(), keyID)
+	} else {
+		err = kpAPI.EnableKey(context.Background(), keyID)
+	}
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error while setting key state to %s: %s", state, err)
+	}
+
+	return waitForKMSKeyState(kpAPI, keyID, state, timeout)
+}
+
+func waitForKMSKeyState(kpAPI *kp.Client, keyID, state string, timeout time.Duration) error {
+	pending := []string{}
+	for _, s := range []string{kmsKeyStatePreActivation, kmsKeyStateActive, kmsKeyStateSuspended, kmsKeyStateDeactivated, kmsKeyStateDestroyed} {
+		if s != state {
+			pending = append(pending, s)
+		}
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending: pending,
+		Target:  []string{state},
+		Refresh: func() (interface{}, string, error) {
+			key, err := kpAPI.GetKeyMetadata(context.Background(), keyID)
+			if err != nil {
+				return nil, "", fmt.Errorf("[ERROR] Error while retrieving key: %s", err)
+			}
+			return key, kmsKeyStateName(key.State), nil
+		},
+		Timeout:    timeout,
+		Delay:      2 * time.Second,
+		MinTimeout: 2 * time.Second,
+	}
+
+	_, err := stateConf.WaitForState()
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error waiting for key %s to be %s: %s", keyID, state, err)
+	}
+	return nil
+}
+
+func resourceIBMKmsKeyActionDiff(context context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if diff.Id() != "" && !diff.HasChanges("instance_id", "endpoint_type", "key_id", "action", "payload", "key_material", "triggers") {
+		return nil
+	}
+
+	action := diff.Get("action").(string)
+	_, withPayload := diff.GetOk("payload")
+	_, withKeyMaterial := diff.GetOk("key_material")
+	if action != kmsKeyActionRotate && action != kmsKeyActionRestore {
+		if withPayload {
+			return fmt.Errorf("[ERROR] payload is only supported for the %s and %s actions", kmsKeyActionRotate, kmsKeyActionRestore)
+		}
+		if withKeyMaterial {
+			return fmt.Errorf("[ERROR] key_material is only supported for the %s and %s actions", kmsKeyActionRotate, kmsKeyActionRestore)
+		}
+	}
+
+	if !diff.NewValueKnown("instance_id") || !diff.NewValueKnown("key_id") {
+		return nil
+	}
+
+	// The instance or the key may not exist yet
+	instanceID := getInstanceIDFromCRN(diff.Get("instance_id").(string))
+	kpAPI, _, err := populateKPClientWithEndpoint(meta, instanceID, diff.Get("endpoint_type").(string))
+	if err != nil {
+		log.Printf("[DEBUG] Skipping key action validation: %s", err)
+		return nil
+	}
+	key, err := kpAPI.GetKeyMetadata(context, diff.Get("key_id").(string))
+	if err != nil {
+		log.Printf("[DEBUG] Skipping key action validation: %s", err)
+		return nil
+	}
+
+	// An unknown payload or key material is set at apply time
+	withKeyMaterial = withPayload || withKeyMaterial || !diff.NewValueKnown("payload") || !diff.NewValueKnown("key_material")
+	return validateKMSKeyAction(action, key, withKeyMaterial)
+}
+
+func resourceIBMKmsKeyActionCreate(d *schema.ResourceData, meta interface{}) error {
+	instanceID := getInstanceIDFromCRN(d.Get("instance_id").(string))
+	kpAPI, instanceCRN, err := populateKPClient(d, meta, instanceID)
+	if err != nil {
+		return err
+	}
+
+	keyID := d.Get("key_id").(string)
+	action := d.Get("action").(string)
+
+	key, err := kpAPI.GetKeyMetadata(context.Background(), keyID)
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error while retrieving key: %s", err)
+	}
+	_, withPayload := d.GetOk("payload")
+	_, withKeyMaterial := d.GetOk("key_material")
+	err = validateKMSKeyAction(action, key, withPayload || withKeyMaterial)
+	if err != nil {
+		return err
+	}
+
+	performedAt := time.Now()
+	switch action {
+	case kmsKeyActionRotate:
+		if withKeyMaterial {
+			var keyMaterial kp.KeysActionRequest
+			keyMaterial, err = wrapKMSKeyActionKeyMaterial(kpAPI, *instanceCRN, d.Get("key_material").(string))
+			if err != nil {
+				return err
+			}
+			keyPayload := kp.NewKeyPayload(keyMaterial.Payload, keyMaterial.EncryptedNonce, keyMaterial.IV)
+			if keyMaterial.EncryptionAlgorithm == kmsKeyEncryptionAlgorithmRSA1 {
+				keyPayload = keyPayload.WithRSA1()
+			} else {
+				keyPayload = keyPayload.WithRSA256()
+			}
+			err = kpAPI.RotateV2(context.Background(), key.ID, &keyPayload)
+		} else {
+			err = kpAPI.Rotate(context.Background(), key.ID, d.Get("payload").(string))
+		}
+	case kmsKeyActionDisable:
+		err = kpAPI.DisableKey(context.Background(), key.ID)
+	case kmsKeyActionEnable:
+		err = kpAPI.EnableKey(context.Background(), key.ID)
+	case kmsKeyActionSetDeletion:
+		err = kpAPI.InitiateDualAuthDelete(context.Background(), key.ID)
+	case kmsKeyActionUnsetDeletion:
+		err = kpAPI.CancelDualAuthDelete(context.Background(), key.ID)
+	case kmsKeyActionRestore:
+		if !withKeyMaterial && !withPayload {
+			_, err = kpAPI.RestoreKey(context.Background(), key.ID)
+			break
+		}
+		keyMaterial := kp.KeysActionRequest{Payload: d.Get("payload").(string)}
+		if withKeyMaterial {
+			keyMaterial, err = wrapKMSKeyActionKeyMaterial(kpAPI, *instanceCRN, d.Get("key_material").(string))
+			if err != nil {
+				return err
+			}
+		}
+		var authorization string
+		authorization, err = kmsAuthorization(kpAPI, meta)
+		if err != nil {
+			return err
+		}
+		err = restoreKMSKey(kpAPI, authorization, key.ID, &keyMaterial)
+	}
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error while performing key action %s: %s", action, err)
+	}
+
+	d.SetId(fmt.Sprintf("%s:%s", action, key.CRN))
+	d.Set("performed_at", performedAt.UTC().Format(time.RFC3339))
+
+	switch action {
+	case kmsKeyActionDisable:
+		err = waitForKMSKeyState(kpAPI, key.ID, kmsKeyStateSuspended, d.Timeout(schema.TimeoutCreate))
+	case kmsKeyActionEnable, kmsKeyActionRestore:
+		err = waitForKMSKeyState(kpAPI, key.ID, kmsKeyStateActive, d.Timeout(schema.TimeoutCreate))
+	}
+	if err != nil {
+		return err
+	}
+
+	return resourceIBMKmsKeyActionRead(d, meta)
+}
+
+// Encryption algorithms of key material wrapped with an import token
+const (
+	kmsKeyEncryptionAlgorithmRSA1   = "RSAES_OAEP_SHA_1"
+	kmsKeyEncryptionAlgorithmRSA256 = "RSAES_OAEP_SHA_256"
+)
+
+// wrapKMSKeyActionKeyMaterial wraps the key material with the import token of the instance. Hyper Protect Crypto
+// Services only supports SHA-1 for RSA-OAEP.
+func wrapKMSKeyActionKeyMaterial(kpAPI *kp.Client, instanceCRN, keyMaterial string) (kp.KeysActionRequest, error) {
+	hpcs := strings.Split(instanceCRN, ":")[4] == "hs-crypto"
+	payload, encryptedNonce, iv, err := wrapKeyMaterial(kpAPI, keyMaterial, hpcs)
+	if err != nil {
+		return kp.KeysActionRequest{}, err
+	}
+	wrapped := kp.KeysActionRequest{
+		Payload:             payload,
+		EncryptedNonce:      encryptedNonce,
+		IV:                  iv,
+		EncryptionAlgorithm: kmsKeyEncryptionAlgorithmRSA256,
+	}
+	if hpcs {
+		wrapped.EncryptionAlgorithm = kmsKeyEncryptionAlgorithmRSA1
+	}
+	return wrapped, nil
+}
+
+// kmsKeyRestoreRequest is the request to restore an imported key with its key material.
+type kmsKeyRestoreRequest struct {
+	Metadata  kp.KeysMetadata         `json:"metadata"`
+	Resources []*kp.KeysActionRequest `json:"resources"`
+}
+
+// kmsAuthorization returns the authorization header of the key protect client, or the IAM access token of the
+// session if the client authenticates with an API key.
+func kmsAuthorization(kpAPI *kp.Client, meta interface{}) (string, error) {
+	if kpAPI.Config.Authorization != "" {
+		return kpAPI.Config.Authorization, nil
+	}
+	sess, err := meta.(conns.ClientSession).BluemixSession()
+	if err != nil {
+		return "", err
+	}
+	return sess.Config.IAMAccessToken, nil
+}
+
+// restoreKMSKey restores an imported key with its key material. The key protect client only restores keys without a
+// body, so the request is sent with its HTTP client instead.
+func restoreKMSKey(kpAPI *kp.Client, authorization, keyID string, keyMaterial *kp.KeysActionRequest) error {
+	body, err := json.Marshal(kmsKeyRestoreRequest{
+		Metadata: kp.KeysMetadata{
+			CollectionType: "application/vnd.ibm.kms.key+json",
+			NumberOfKeys:   1,
+		},
+		Resources: []*kp.KeysActionRequest{keyMaterial},
+	})
+	if err != nil {
+		return err
+	}
+
+	restoreURL, err := kpAPI.URL.Parse(fmt.Sprintf("keys/%s/restore", url.PathEscape(keyID)))
+	if err != nil {
+		return err
+	}
+	request, err := http.NewRequest(http.MethodPost, restoreURL.String(), bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	request.Header.Set("Accept", "application/json")
+	request.Header.Set("Content-Type", "application/vnd.ibm.kms.key+json")
+	request.Header.Set("Authorization", authorization)
+	request.Header.Set("Bluemix-Instance", kpAPI.Config.InstanceID)
+	if kpAPI.Config.KeyRing != "" {
+		request.Header.Set("X-Kms-Key-Ring", kpAPI.Config.KeyRing)
+	}
+
+	response, err := kpAPI.HttpClient.Do(request)
+	if err != nil {
+		return err
+	}
+	defer response.Body.Close()
+	if response.StatusCode < 200 || response.StatusCode > 299 {
+		responseBody, _ := io.ReadAll(response.Body)
+		return fmt.Errorf("[ERROR] Error restoring key (%s): %s %s", keyID, response.Status, responseBody)
+	}
+	return nil
+}
+
+func resourceIBMKmsKeyActionRead(d *schema.ResourceData, meta interface{}) error {
+	parts := strings.SplitN(d.Id(), ":", 2)
+	if len(parts) != 2 || !strings.Contains(parts[1], ":key:") {
+		return fmt.Errorf("[ERROR] Incorrect ID %s: Id should be a combination of action:keyCRN", d.Id())
+	}
+	action, crn := parts[0], parts[1]
+	_, instanceID, keyID := getInstanceAndKeyDataFromCRN(crn)
+
+	kpAPI, _, err := populateKPClient(d, meta, instanceID)
+	if err != nil {
+		return err
+	}
+
+	key, err := kpAPI.GetKeyMetadata(context.Background(), keyID)
+	if err != nil {
+		if kpError, ok := err.(*kp.Error); ok && kpError.StatusCode == 404 {
+			log.Printf("[WARN] Removing key action (%s) from state because the key is not found", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("[ERROR] Error while retrieving key: %s", err)
+	}
+
+	d.Set("instance_id", instanceID)
+	d.Set("action", action)
+	d.Set("crn", key.CRN)
+	d.Set("key_state", kmsKeyStateName(key.State))
+	if _, ok := d.GetOk("key_id"); !ok {
+		d.Set("key_id", key.ID)
+	}
+	if strings.Contains((kpAPI.URL).String(), "private") || strings.Contains(kpAPI.Config.BaseURL, "private") {
+		d.Set("endpoint_type", "private")
+	} else {
+		d.Set("endpoint_type", "public")
+	}
+	return nil
+}
+
+func resourceIBMKmsKeyActionDelete(d *schema.ResourceData, meta interface{}) error {
+	// Key actions can't be undone by destroying the resource, use the opposite action instead.
+	log.Printf("[WARN] Key action (%s) is removed from state only, the key is not changed", d.Id())
+	d.SetId("")
+	return nil
+}
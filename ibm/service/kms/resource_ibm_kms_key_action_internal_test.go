@@ -0,0 +1,114 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package kms
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	kp "github.com/IBM/keyprotect-go-client"
+	"gotest.tools/assert"
+)
+
+func TestValidateKMSKeyAction(t *testing.T) {
+	testcases := []struct {
+		action          string
+		key             kp.Key
+		withKeyMaterial bool
+		expectedError   string
+	}{
+		{
+			action: kmsKeyActionRotate,
+			key:    kp.Key{ID: "key", State: int(kp.Active)},
+		},
+		{
+			action:        kmsKeyActionRotate,
+			key:           kp.Key{ID: "key", State: int(kp.Suspended)},
+			expectedError: "[ERROR] Action rotate can't be performed on key key in the suspended state, the key must be active",
+		},
+		{
+			action:        kmsKeyActionDisable,
+			key:           kp.Key{ID: "key", State: int(kp.Active), Extractable: true},
+			expectedError: "[ERROR] Action disable can only be performed on root keys",
+		},
+		{
+			action: kmsKeyActionRestore,
+			key:    kp.Key{ID: "key", State: int(kp.Destroyed)},
+		},
+		{
+			action:          kmsKeyActionRestore,
+			key:             kp.Key{ID: "key", State: int(kp.Destroyed), Imported: true},
+			withKeyMaterial: true,
+		},
+		{
+			action:        kmsKeyActionRestore,
+			key:           kp.Key{ID: "key", State: int(kp.Destroyed), Imported: true},
+			expectedError: "[ERROR] Key key was imported and can only be restored with its key material, set key_material or payload",
+		},
+		{
+			action:          kmsKeyActionRestore,
+			key:             kp.Key{ID: "key", State: int(kp.Destroyed)},
+			withKeyMaterial: true,
+			expectedError:   "[ERROR] Key key was not imported and is restored without key material, remove key_material and payload",
+		},
+	}
+	for _, tc := range testcases {
+		err := validateKMSKeyAction(tc.action, &tc.key, tc.withKeyMaterial)
+		if tc.expectedError == "" {
+			assert.NilError(t, err, "action %s", tc.action)
+			continue
+		}
+		assert.Error(t, err, tc.expectedError)
+	}
+}
+
+func TestRestoreKMSKey(t *testing.T) {
+	var method, path, contentType, authorization, instance string
+	var restoreRequest kmsKeyRestoreRequest
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		method, path, contentType = r.Method, r.URL.Path, r.Header.Get("Content-Type")
+		authorization, instance = r.Header.Get("Authorization"), r.Header.Get("Bluemix-Instance")
+		if r.URL.Path == "/api/v2/keys/missing/restore" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		body, _ := io.ReadAll(r.Body)
+		json.Unmarshal(body, &restoreRequest)
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+		w.Write([]byte(`{"metadata":{"collectionType":"application/vnd.ibm.kms.key+json","collectionTotal":1},"resources":[{"id":"key"}]}`))
+	}))
+	defer server.Close()
+
+	kpAPI, err := kp.New(kp.ClientConfig{
+		BaseURL:       server.URL,
+		Authorization: "Bearer token",
+		InstanceID:    "instance",
+		Verbose:       kp.VerboseFailOnly,
+	}, nil)
+	assert.NilError(t, err)
+
+	keyMaterial := &kp.KeysActionRequest{
+		Payload:             "payload",
+		EncryptedNonce:      "nonce",
+		IV:                  "iv",
+		EncryptionAlgorithm: kmsKeyEncryptionAlgorithmRSA256,
+	}
+	assert.NilError(t, restoreKMSKey(kpAPI, "Bearer token", "key", keyMaterial))
+
+	assert.Equal(t, method, http.MethodPost)
+	assert.Equal(t, path, "/api/v2/keys/key/restore")
+	assert.Equal(t, contentType, "application/vnd.ibm.kms.key+json")
+	assert.Equal(t, authorization, "Bearer token")
+	assert.Equal(t, instance, "instance")
+	assert.DeepEqual(t, restoreRequest, kmsKeyRestoreRequest{
+		Metadata:  kp.KeysMetadata{CollectionType: "application/vnd.ibm.kms.key+json", NumberOfKeys: 1},
+		Resources: []*kp.KeysActionRequest{keyMaterial},
+	})
+
+	assert.ErrorContains(t, restoreKMSKey(kpAPI, "Bearer token", "missing", keyMaterial), "404 Not Found")
+}
@@ -0,0 +1,120 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package kms_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIBMKMSKeyAction_basic(t *testing.T) {
+	instanceName := fmt.Sprintf("kms_%d", acctest.RandIntRange(10, 100))
+	keyName := fmt.Sprintf("key_%d", acctest.RandIntRange(10, 100))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMKmsKeyActionConfig(instanceName, keyName, "rotate"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_kms_key_action.action", "action", "rotate"),
+					resource.TestCheckResourceAttr("ibm_kms_key_action.action", "key_state", "active"),
+					resource.TestCheckResourceAttrSet("ibm_kms_key_action.action", "performed_at"),
+				),
+			},
+			{
+				Config: testAccCheckIBMKmsKeyActionConfig(instanceName, keyName, "disable"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_kms_key_action.action", "action", "disable"),
+					resource.TestCheckResourceAttr("ibm_kms_key_action.action", "key_state", "suspended"),
+				),
+			},
+			{
+				Config: testAccCheckIBMKmsKeyActionConfig(instanceName, keyName, "enable"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_kms_key_action.action", "action", "enable"),
+					resource.TestCheckResourceAttr("ibm_kms_key_action.action", "key_state", "active"),
+				),
+			},
+			{
+				Config:      testAccCheckIBMKmsKeyActionConfig(instanceName, keyName, "restore"),
+				ExpectError: regexp.MustCompile("the key must be destroyed"),
+			},
+		},
+	})
+}
+
+func TestAccIBMKMSResource_KeyState(t *testing.T) {
+	instanceName := fmt.Sprintf("kms_%d", acctest.RandIntRange(10, 100))
+	keyName := fmt.Sprintf("key_%d", acctest.RandIntRange(10, 100))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMKmsKeyStateConfig(instanceName, keyName, false, "suspended"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_kms_key.test", "key_state", "suspended"),
+				),
+			},
+			{
+				Config: testAccCheckIBMKmsKeyStateConfig(instanceName, keyName, false, "active"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_kms_key.test", "key_state", "active"),
+				),
+			},
+			{
+				Config:      testAccCheckIBMKmsKeyStateConfig(instanceName, keyName, true, "suspended"),
+				ExpectError: regexp.MustCompile("only supported for root keys"),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMKmsKeyActionConfig(instanceName, keyName, action string) string {
+	return fmt.Sprintf(`
+	resource "ibm_resource_instance" "kms_instance" {
+		name              = "%s"
+		service           = "kms"
+		plan              = "tiered-pricing"
+		location          = "us-south"
+	}
+	resource "ibm_kms_key" "test" {
+		instance_id  = ibm_resource_instance.kms_instance.guid
+		key_name     = "%s"
+		standard_key = false
+		force_delete = true
+	}
+	resource "ibm_kms_key_action" "action" {
+		instance_id = ibm_kms_key.test.instance_id
+		key_id      = ibm_kms_key.test.key_id
+		action      = "%s"
+	}
+`, instanceName, keyName, action)
+}
+
+func testAccCheckIBMKmsKeyStateConfig(instanceName, keyName string, standardKey bool, keyState string) string {
+	return fmt.Sprintf(`
+	resource "ibm_resource_instance" "kms_instance" {
+		name              = "%s"
+		service           = "kms"
+		plan              = "tiered-pricing"
+		location          = "us-south"
+	}
+	resource "ibm_kms_key" "test" {
+		instance_id  = ibm_resource_instance.kms_instance.guid
+		key_name     = "%s"
+		standard_key = %t
+		key_state    = "%s"
+		force_delete = true
+	}
+`, instanceName, keyName, standardKey, keyState)
+}
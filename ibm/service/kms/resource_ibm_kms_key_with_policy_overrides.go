@@ -110,6 +110,11 @@ func ResourceIBMKmsKeyWithPolicyOverrides() *schema.Resource {
 				Computed:    true,
 				Description: "Crn of the key",
 			},
+			"key_state": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The state of the key",
+			},
 			"expiration_date": {
 				Type:        schema.TypeString,
 				Optional:    true,
@@ -0,0 +1,238 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package kms
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	kp "github.com/IBM/keyprotect-go-client"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func ResourceIBMKmsKMIPAdapter() *schema.Resource {
+	return &schema.Resource{
+		Create:        resourceIBMKmsKMIPAdapterCreate,
+		Read:          resourceIBMKmsKMIPAdapterRead,
+		Delete:        resourceIBMKmsKMIPAdapterDelete,
+		CustomizeDiff: resourceIBMKmsKMIPAdapterDiff,
+		Importer:      &schema.ResourceImporter{},
+
+		Schema: map[string]*schema.Schema{
+			"instance_id": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				Description:      "Key protect instance GUID or CRN",
+				DiffSuppressFunc: suppressKMSInstanceIDDiff,
+			},
+			"endpoint_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.ValidateAllowedStringValues([]string{"public", "private"}),
+				Description:  "public or private",
+			},
+			"profile": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      kp.KMIP_Profile_Native,
+				ValidateFunc: validate.ValidateAllowedStringValues([]string{kp.KMIP_Profile_Native}),
+				Description:  "The profile of the KMIP adapter, which defines how the adapter manages keys",
+			},
+			"profile_data": {
+				Type:        schema.TypeMap,
+				Required:    true,
+				ForceNew:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The data of the profile. The native_1.0 profile requires crk_id, the ID of the root key that the adapter wraps its KMIP objects with",
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: "The name of the KMIP adapter. Generated if not set",
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "The description of the KMIP adapter",
+			},
+			"adapter_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The ID of the KMIP adapter",
+			},
+			"created_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The date the KMIP adapter was created",
+			},
+			"created_by": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The ID of the user that created the KMIP adapter",
+			},
+			"updated_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The date the KMIP adapter was last updated",
+			},
+			"updated_by": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The ID of the user that last updated the KMIP adapter",
+			},
+		},
+	}
+}
+
+func resourceIBMKmsKMIPAdapterDiff(context context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if !diff.NewValueKnown("profile_data") {
+		return nil
+	}
+	return validateKMIPAdapterProfile(diff.Get("profile").(string), diff.Get("profile_data").(map[string]interface{}))
+}
+
+// validateKMIPAdapterProfile checks that the profile data has the keys that the profile requires, and no others.
+func validateKMIPAdapterProfile(profile string, profileData map[string]interface{}) error {
+	if profile != kp.KMIP_Profile_Native {
+		return nil
+	}
+	if crkID, ok := profileData["crk_id"]; !ok || crkID == "" {
+		return fmt.Errorf("[ERROR] profile_data must set crk_id for the %s profile", profile)
+	}
+	for key := range profileData {
+		if key != "crk_id" {
+			return fmt.Errorf("[ERROR] profile_data key %s is not supported for the %s profile, only crk_id is supported", key, profile)
+		}
+	}
+	return nil
+}
+
+func resourceIBMKmsKMIPAdapterCreate(d *schema.ResourceData, meta interface{}) error {
+	instanceID := getInstanceIDFromCRN(d.Get("instance_id").(string))
+	kpAPI, instanceCRN, err := populateKPClient(d, meta, instanceID)
+	if err != nil {
+		return err
+	}
+
+	profileData := d.Get("profile_data").(map[string]interface{})
+	if err = validateKMIPAdapterProfile(d.Get("profile").(string), profileData); err != nil {
+		return err
+	}
+
+	options := []kp.CreateKMIPAdapterOption{}
+	if name, ok := d.GetOk("name"); ok {
+		options = append(options, kp.WithKMIPAdapterName(name.(string)))
+	}
+	if description, ok := d.GetOk("description"); ok {
+		options = append(options, kp.WithKMIPAdapterDescription(description.(string)))
+	}
+
+	adapter, err := kpAPI.CreateKMIPAdapter(context.Background(), kp.WithNativeProfile(profileData["crk_id"].(string)), options...)
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error while creating KMIP adapter: %s", err)
+	}
+
+	d.SetId(fmt.Sprintf("%s:kmipAdapter:%s", adapter.ID, *instanceCRN))
+
+	return resourceIBMKmsKMIPAdapterRead(d, meta)
+}
+
+// parseKMIPAdapterID returns the adapter ID and the instance CRN of a KMIP adapter resource ID.
+func parseKMIPAdapterID(id string) (adapterID, instanceCRN string, err error) {
+	parts := strings.Split(id, ":kmipAdapter:")
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", fmt.Errorf("[ERROR] Incorrect ID %s: Id should be a combination of adapterID:kmipAdapter:InstanceCRN", id)
+	}
+	return parts[0], parts[1], nil
+}
+
+func resourceIBMKmsKMIPAdapterRead(d *schema.ResourceData, meta interface{}) error {
+	adapterID, instanceCRN, err := parseKMIPAdapterID(d.Id())
+	if err != nil {
+		return err
+	}
+	instanceID := getInstanceIDFromCRN(instanceCRN)
+	kpAPI, _, err := populateKPClient(d, meta, instanceID)
+	if err != nil {
+		return err
+	}
+
+	adapter, err := kpAPI.GetKMIPAdapter(context.Background(), adapterID)
+	if err != nil {
+		if kpError, ok := err.(*kp.Error); ok && kpError.StatusCode == 404 {
+			log.Printf("[WARN] Removing KMIP adapter (%s) from state because it's not found", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("[ERROR] Error while retrieving KMIP adapter: %s", err)
+	}
+
+	d.Set("instance_id", instanceID)
+	if strings.Contains((kpAPI.URL).String(), "private") || strings.Contains(kpAPI.Config.BaseURL, "private") {
+		d.Set("endpoint_type", "private")
+	} else {
+		d.Set("endpoint_type", "public")
+	}
+	for key, value := range flattenKMIPAdapter(adapter) {
+		if key == "id" {
+			key = "adapter_id"
+		}
+		d.Set(key, value)
+	}
+	return nil
+}
+
+func resourceIBMKmsKMIPAdapterDelete(d *schema.ResourceData, meta interface{}) error {
+	adapterID, instanceCRN, err := parseKMIPAdapterID(d.Id())
+	if err != nil {
+		return err
+	}
+	kpAPI, _, err := populateKPClient(d, meta, getInstanceIDFromCRN(instanceCRN))
+	if err != nil {
+		return err
+	}
+
+	err = kpAPI.DeleteKMIPAdapter(context.Background(), adapterID)
+	if err != nil {
+		if kpError, ok := err.(*kp.Error); ok && kpError.StatusCode == 404 {
+			return nil
+		}
+		return fmt.Errorf("[ERROR] Error while deleting KMIP adapter: %s", err)
+	}
+	return nil
+}
+
+func flattenKMIPAdapter(adapter *kp.KMIPAdapter) map[string]interface{} {
+	profileData := map[string]interface{}{}
+	for key, value := range adapter.ProfileData {
+		profileData[key] = value
+	}
+	flattened := map[string]interface{}{
+		"id":           adapter.ID,
+		"name":         adapter.Name,
+		"description":  adapter.Description,
+		"profile":      adapter.Profile,
+		"profile_data": profileData,
+		"created_by":   adapter.CreatedBy,
+		"updated_by":   adapter.UpdatedBy,
+	}
+	if adapter.CreatedAt != nil {
+		flattened["created_at"] = adapter.CreatedAt.Format(time.RFC3339)
+	}
+	if adapter.UpdatedAt != nil {
+		flattened["updated_at"] = adapter.UpdatedAt.Format(time.RFC3339)
+	}
+	return flattened
+}
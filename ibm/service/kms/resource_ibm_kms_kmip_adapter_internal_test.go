@@ -0,0 +1,116 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package kms
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+
+	kp "github.com/IBM/keyprotect-go-client"
+	"gotest.tools/assert"
+)
+
+func TestValidateKMIPAdapterProfile(t *testing.T) {
+	testcases := []struct {
+		profile       string
+		profileData   map[string]interface{}
+		expectedError string
+	}{
+		{
+			profile:     kp.KMIP_Profile_Native,
+			profileData: map[string]interface{}{"crk_id": "key"},
+		},
+		{
+			profile:       kp.KMIP_Profile_Native,
+			profileData:   map[string]interface{}{},
+			expectedError: "[ERROR] profile_data must set crk_id for the native_1.0 profile",
+		},
+		{
+			profile:       kp.KMIP_Profile_Native,
+			profileData:   map[string]interface{}{"crk_id": ""},
+			expectedError: "[ERROR] profile_data must set crk_id for the native_1.0 profile",
+		},
+		{
+			profile:       kp.KMIP_Profile_Native,
+			profileData:   map[string]interface{}{"crk_id": "key", "key_ring": "ring"},
+			expectedError: "[ERROR] profile_data key key_ring is not supported for the native_1.0 profile, only crk_id is supported",
+		},
+	}
+	for _, tc := range testcases {
+		err := validateKMIPAdapterProfile(tc.profile, tc.profileData)
+		if tc.expectedError == "" {
+			assert.NilError(t, err)
+			continue
+		}
+		assert.Error(t, err, tc.expectedError)
+	}
+}
+
+func TestParseKMIPIDs(t *testing.T) {
+	instanceCRN := "crn:v1:bluemix:public:kms:us-south:a/1234:5678::"
+
+	adapterID, crn, err := parseKMIPAdapterID("adapter:kmipAdapter:" + instanceCRN)
+	assert.NilError(t, err)
+	assert.Equal(t, adapterID, "adapter")
+	assert.Equal(t, crn, instanceCRN)
+
+	for _, id := range []string{"adapter", "adapter:kmipAdapter:", ":kmipAdapter:" + instanceCRN} {
+		_, _, err = parseKMIPAdapterID(id)
+		assert.Error(t, err, fmt.Sprintf("[ERROR] Incorrect ID %s: Id should be a combination of adapterID:kmipAdapter:InstanceCRN", id))
+	}
+
+	adapterID, certID, crn, err := parseKMIPClientCertificateID("adapter:cert:kmipClientCert:" + instanceCRN)
+	assert.NilError(t, err)
+	assert.Equal(t, adapterID, "adapter")
+	assert.Equal(t, certID, "cert")
+	assert.Equal(t, crn, instanceCRN)
+
+	for _, id := range []string{"cert:kmipClientCert:" + instanceCRN, "adapter:cert:kmipClientCert:", "adapter::kmipClientCert:" + instanceCRN} {
+		_, _, _, err = parseKMIPClientCertificateID(id)
+		assert.Error(t, err, fmt.Sprintf("[ERROR] Incorrect ID %s: Id should be a combination of adapterID:certID:kmipClientCert:InstanceCRN", id))
+	}
+}
+
+func TestListKMIPAdapters(t *testing.T) {
+	// 450 adapters are listed in pages of 200
+	total := 450
+	var queries []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		queries = append(queries, r.URL.RawQuery)
+		offset, _ := strconv.Atoi(r.URL.Query().Get("offset"))
+		limit, _ := strconv.Atoi(r.URL.Query().Get("limit"))
+		adapters := kp.KMIPAdapters{}
+		for i := offset; i < offset+limit && i < total; i++ {
+			adapters.Adapters = append(adapters.Adapters, kp.KMIPAdapter{ID: strconv.Itoa(i)})
+		}
+		adapters.Metadata.CollectionTotal = len(adapters.Adapters)
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(adapters)
+	}))
+	defer server.Close()
+
+	kpAPI, err := kp.New(kp.ClientConfig{
+		BaseURL:       server.URL,
+		Authorization: "Bearer token",
+		InstanceID:    "instance",
+		Verbose:       kp.VerboseFailOnly,
+	}, nil)
+	assert.NilError(t, err)
+
+	adapters, err := listKMIPAdapters(kpAPI, "key")
+	assert.NilError(t, err)
+	assert.Equal(t, len(adapters), total)
+	for i, adapter := range adapters {
+		assert.Equal(t, adapter.ID, strconv.Itoa(i))
+	}
+	assert.DeepEqual(t, queries, []string{
+		"crk_id=key&limit=200&offset=0",
+		"crk_id=key&limit=200&offset=200",
+		"crk_id=key&limit=200&offset=400",
+	})
+}
@@ -0,0 +1,72 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package kms_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIBMKMSKMIPAdapter_basic(t *testing.T) {
+	instanceName := fmt.Sprintf("kms_%d", acctest.RandIntRange(10, 100))
+	keyName := fmt.Sprintf("key_%d", acctest.RandIntRange(10, 100))
+	adapterName := fmt.Sprintf("kmip-adapter-%d", acctest.RandIntRange(10, 100))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMKmsKMIPAdapterConfig(instanceName, keyName, adapterName, "crk_id = ibm_kms_key.test.key_id"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_kms_kmip_adapter.adapter", "name", adapterName),
+					resource.TestCheckResourceAttr("ibm_kms_kmip_adapter.adapter", "description", "KMIP adapter for storage appliances"),
+					resource.TestCheckResourceAttr("ibm_kms_kmip_adapter.adapter", "profile", "native_1.0"),
+					resource.TestCheckResourceAttrPair("ibm_kms_kmip_adapter.adapter", "profile_data.crk_id", "ibm_kms_key.test", "key_id"),
+					resource.TestCheckResourceAttrSet("ibm_kms_kmip_adapter.adapter", "adapter_id"),
+					resource.TestCheckResourceAttrSet("ibm_kms_kmip_adapter.adapter", "created_at"),
+				),
+			},
+			{
+				ResourceName:      "ibm_kms_kmip_adapter.adapter",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config:      testAccCheckIBMKmsKMIPAdapterConfig(instanceName, keyName, adapterName, ""),
+				ExpectError: regexp.MustCompile("profile_data must set crk_id"),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMKmsKMIPAdapterConfig(instanceName, keyName, adapterName, profileData string) string {
+	return fmt.Sprintf(`
+	resource "ibm_resource_instance" "kms_instance" {
+		name              = "%s"
+		service           = "kms"
+		plan              = "tiered-pricing"
+		location          = "us-south"
+	}
+	resource "ibm_kms_key" "test" {
+		instance_id  = ibm_resource_instance.kms_instance.guid
+		key_name     = "%s"
+		standard_key = false
+		force_delete = true
+	}
+	resource "ibm_kms_kmip_adapter" "adapter" {
+		instance_id  = ibm_resource_instance.kms_instance.guid
+		name         = "%s"
+		description  = "KMIP adapter for storage appliances"
+		profile_data = {
+			%s
+		}
+	}
+`, instanceName, keyName, adapterName, profileData)
+}
@@ -0,0 +1,207 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package kms
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	kp "github.com/IBM/keyprotect-go-client"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func ResourceIBMKmsKMIPClientCertificate() *schema.Resource {
+	return &schema.Resource{
+		Create:   resourceIBMKmsKMIPClientCertificateCreate,
+		Read:     resourceIBMKmsKMIPClientCertificateRead,
+		Delete:   resourceIBMKmsKMIPClientCertificateDelete,
+		Importer: &schema.ResourceImporter{},
+
+		Schema: map[string]*schema.Schema{
+			"instance_id": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				Description:      "Key protect instance GUID or CRN",
+				DiffSuppressFunc: suppressKMSInstanceIDDiff,
+			},
+			"endpoint_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.ValidateAllowedStringValues([]string{"public", "private"}),
+				Description:  "public or private",
+			},
+			"adapter_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The ID of the KMIP adapter the client certificate is registered with",
+			},
+			"certificate": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.InvokeValidator("ibm_kms_kmip_client_cert", "certificate"),
+				DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+					return strings.TrimSpace(old) == strings.TrimSpace(new)
+				},
+				Description: "The PEM encoded client certificate",
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: "The name of the client certificate. Generated if not set",
+			},
+			"cert_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The ID of the client certificate",
+			},
+			"created_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The date the client certificate was registered",
+			},
+			"created_by": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The ID of the user that registered the client certificate",
+			},
+		},
+	}
+}
+
+func ResourceIBMKmsKMIPClientCertificateValidator() *validate.ResourceValidator {
+	validateSchema := make([]validate.ValidateSchema, 0)
+
+	validateSchema = append(validateSchema,
+		validate.ValidateSchema{
+			Identifier:                 "certificate",
+			ValidateFunctionIdentifier: validate.ValidateRegexpLen,
+			Type:                       validate.TypeString,
+			Required:                   true,
+			Regexp:                     `^\s*-----BEGIN CERTIFICATE-----[A-Za-z0-9+\/\=\r\n]+-----END CERTIFICATE-----\s*$`,
+			MinValueLength:             1,
+			MaxValueLength:             8000})
+
+	ibmKMIPClientCertificateResourceValidator := validate.ResourceValidator{ResourceName: "ibm_kms_kmip_client_cert", Schema: validateSchema}
+	return &ibmKMIPClientCertificateResourceValidator
+}
+
+func resourceIBMKmsKMIPClientCertificateCreate(d *schema.ResourceData, meta interface{}) error {
+	instanceID := getInstanceIDFromCRN(d.Get("instance_id").(string))
+	kpAPI, instanceCRN, err := populateKPClient(d, meta, instanceID)
+	if err != nil {
+		return err
+	}
+
+	adapterID := d.Get("adapter_id").(string)
+	options := []kp.CreateKMIPClientCertOption{}
+	if name, ok := d.GetOk("name"); ok {
+		options = append(options, kp.WithKMIPClientCertName(name.(string)))
+	}
+
+	cert, err := kpAPI.CreateKMIPClientCertificate(context.Background(), adapterID, d.Get("certificate").(string), options...)
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error while registering KMIP client certificate: %s", err)
+	}
+
+	d.SetId(fmt.Sprintf("%s:%s:kmipClientCert:%s", adapterID, cert.ID, *instanceCRN))
+
+	return resourceIBMKmsKMIPClientCertificateRead(d, meta)
+}
+
+// parseKMIPClientCertificateID returns the adapter ID, the certificate ID and the instance CRN of a KMIP client
+// certificate resource ID.
+func parseKMIPClientCertificateID(id string) (adapterID, certID, instanceCRN string, err error) {
+	parts := strings.Split(id, ":kmipClientCert:")
+	if len(parts) == 2 && parts[1] != "" {
+		ids := strings.Split(parts[0], ":")
+		if len(ids) == 2 && ids[0] != "" && ids[1] != "" {
+			return ids[0], ids[1], parts[1], nil
+		}
+	}
+	return "", "", "", fmt.Errorf("[ERROR] Incorrect ID %s: Id should be a combination of adapterID:certID:kmipClientCert:InstanceCRN", id)
+}
+
+func resourceIBMKmsKMIPClientCertificateRead(d *schema.ResourceData, meta interface{}) error {
+	adapterID, certID, instanceCRN, err := parseKMIPClientCertificateID(d.Id())
+	if err != nil {
+		return err
+	}
+	instanceID := getInstanceIDFromCRN(instanceCRN)
+	kpAPI, _, err := populateKPClient(d, meta, instanceID)
+	if err != nil {
+		return err
+	}
+
+	cert, err := kpAPI.GetKMIPClientCertificate(context.Background(), adapterID, certID)
+	if err != nil {
+		if kpError, ok := err.(*kp.Error); ok && kpError.StatusCode == 404 {
+			log.Printf("[WARN] Removing KMIP client certificate (%s) from state because it's not found", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("[ERROR] Error while retrieving KMIP client certificate: %s", err)
+	}
+
+	d.Set("instance_id", instanceID)
+	if strings.Contains((kpAPI.URL).String(), "private") || strings.Contains(kpAPI.Config.BaseURL, "private") {
+		d.Set("endpoint_type", "private")
+	} else {
+		d.Set("endpoint_type", "public")
+	}
+	d.Set("adapter_id", adapterID)
+	d.Set("cert_id", cert.ID)
+	d.Set("name", cert.Name)
+	if cert.Certificate != "" {
+		d.Set("certificate", cert.Certificate)
+	}
+	d.Set("created_by", cert.CreatedBy)
+	if cert.CreatedAt != nil {
+		d.Set("created_at", cert.CreatedAt.Format(time.RFC3339))
+	}
+	return nil
+}
+
+func resourceIBMKmsKMIPClientCertificateDelete(d *schema.ResourceData, meta interface{}) error {
+	adapterID, certID, instanceCRN, err := parseKMIPClientCertificateID(d.Id())
+	if err != nil {
+		return err
+	}
+	kpAPI, _, err := populateKPClient(d, meta, getInstanceIDFromCRN(instanceCRN))
+	if err != nil {
+		return err
+	}
+
+	err = kpAPI.DeleteKMIPClientCertificate(context.Background(), adapterID, certID)
+	if err != nil {
+		if kpError, ok := err.(*kp.Error); ok && kpError.StatusCode == 404 {
+			return nil
+		}
+		return fmt.Errorf("[ERROR] Error while deleting KMIP client certificate: %s", err)
+	}
+	return nil
+}
+
+func flattenKMIPClientCertificate(cert kp.KMIPClientCertificate) map[string]interface{} {
+	flattened := map[string]interface{}{
+		"id":          cert.ID,
+		"name":        cert.Name,
+		"certificate": cert.Certificate,
+		"created_by":  cert.CreatedBy,
+	}
+	if cert.CreatedAt != nil {
+		flattened["created_at"] = cert.CreatedAt.Format(time.RFC3339)
+	}
+	return flattened
+}
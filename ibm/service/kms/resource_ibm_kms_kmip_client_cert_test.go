@@ -0,0 +1,85 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package kms_test
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"fmt"
+	"math/big"
+	"testing"
+	"time"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIBMKMSKMIPClientCert_basic(t *testing.T) {
+	instanceName := fmt.Sprintf("kms_%d", acctest.RandIntRange(10, 100))
+	keyName := fmt.Sprintf("key_%d", acctest.RandIntRange(10, 100))
+	adapterName := fmt.Sprintf("kmip-adapter-%d", acctest.RandIntRange(10, 100))
+	certName := fmt.Sprintf("kmip-cert-%d", acctest.RandIntRange(10, 100))
+	certificate := testAccKMIPClientCertificate(t)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMKmsKMIPClientCertConfig(instanceName, keyName, adapterName, certName, certificate),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_kms_kmip_client_cert.cert", "name", certName),
+					resource.TestCheckResourceAttrPair("ibm_kms_kmip_client_cert.cert", "adapter_id", "ibm_kms_kmip_adapter.adapter", "adapter_id"),
+					resource.TestCheckResourceAttrSet("ibm_kms_kmip_client_cert.cert", "cert_id"),
+					resource.TestCheckResourceAttrSet("ibm_kms_kmip_client_cert.cert", "created_at"),
+				),
+			},
+			{
+				ResourceName:      "ibm_kms_kmip_client_cert.cert",
+				ImportState:       true,
+				ImportStateVerify: true,
+				// The certificate may be returned with different whitespace
+				ImportStateVerifyIgnore: []string{"certificate"},
+			},
+		},
+	})
+}
+
+// testAccKMIPClientCertificate returns a PEM encoded self-signed client certificate.
+func testAccKMIPClientCertificate(t *testing.T) string {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(time.Now().UnixNano()),
+		Subject:      pkix.Name{CommonName: "terraform-kmip-client"},
+		NotBefore:    time.Now(),
+		NotAfter:     time.Now().Add(24 * time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}))
+}
+
+func testAccCheckIBMKmsKMIPClientCertConfig(instanceName, keyName, adapterName, certName, certificate string) string {
+	return testAccCheckIBMKmsKMIPAdapterConfig(instanceName, keyName, adapterName, "crk_id = ibm_kms_key.test.key_id") + fmt.Sprintf(`
+	resource "ibm_kms_kmip_client_cert" "cert" {
+		instance_id = ibm_resource_instance.kms_instance.guid
+		adapter_id  = ibm_kms_kmip_adapter.adapter.adapter_id
+		name        = "%s"
+		certificate = <<EOT
+%sEOT
+	}
+`, certName, certificate)
+}
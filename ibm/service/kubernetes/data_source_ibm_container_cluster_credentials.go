@@ -0,0 +1,309 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package kubernetes
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	gohttp "net/http"
+	"path"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	yaml "gopkg.in/yaml.v3"
+
+	v1 "github.com/IBM-Cloud/bluemix-go/api/container/containerv1"
+	v2 "github.com/IBM-Cloud/bluemix-go/api/container/containerv2"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+)
+
+func DataSourceIBMContainerClusterCredentials() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceIBMContainerClusterCredentialsRead,
+
+		Schema: map[string]*schema.Schema{
+			"cluster_name_id": {
+				Description: "The name/id of the cluster",
+				Type:        schema.TypeString,
+				Required:    true,
+				ValidateFunc: validate.InvokeDataSourceValidator(
+					"ibm_container_cluster_credentials",
+					"cluster_name_id"),
+			},
+			"resource_group_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "ID of the resource group.",
+			},
+			"admin": {
+				Description: "If set to true the admin client certificate and key are returned",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+			},
+			"endpoint_type": {
+				Description: "The type of the cluster API endpoint that is returned as host",
+				Type:        schema.TypeString,
+				Optional:    true,
+				ValidateFunc: validate.InvokeDataSourceValidator(
+					"ibm_container_cluster_credentials",
+					"endpoint_type"),
+			},
+			"host": {
+				Description: "The URL of the cluster API endpoint",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"ca_certificate": {
+				Description: "The PEM encoded CA certificate of the cluster API endpoint",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"token": {
+				Description: "The bearer token to authenticate to the cluster",
+				Type:        schema.TypeString,
+				Computed:    true,
+				Sensitive:   true,
+			},
+			"token_expiration": {
+				Description: "The expiration time of the token",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"client_certificate": {
+				Description: "The PEM encoded admin client certificate",
+				Type:        schema.TypeString,
+				Computed:    true,
+				Sensitive:   true,
+			},
+			"client_key": {
+				Description: "The PEM encoded admin client key",
+				Type:        schema.TypeString,
+				Computed:    true,
+				Sensitive:   true,
+			},
+		},
+	}
+}
+
+func DataSourceIBMContainerClusterCredentialsValidator() *validate.ResourceValidator {
+	validateSchema := make([]validate.ValidateSchema, 0)
+	validateSchema = append(validateSchema,
+		validate.ValidateSchema{
+			Identifier:                 "cluster_name_id",
+			ValidateFunctionIdentifier: validate.ValidateCloudData,
+			Type:                       validate.TypeString,
+			Required:                   true,
+			CloudDataType:              "cluster",
+			CloudDataRange:             []string{"resolved_to:id"}},
+		validate.ValidateSchema{
+			Identifier:                 "endpoint_type",
+			ValidateFunctionIdentifier: validate.ValidateAllowedStringValue,
+			Type:                       validate.TypeString,
+			Optional:                   true,
+			AllowedValues:              "private,link,vpe"})
+
+	iBMContainerClusterCredentialsValidator := validate.ResourceValidator{ResourceName: "ibm_container_cluster_credentials", Schema: validateSchema}
+	return &iBMContainerClusterCredentialsValidator
+}
+
+func dataSourceIBMContainerClusterCredentialsRead(d *schema.ResourceData, meta interface{}) error {
+	csClient, err := meta.(conns.ClientSession).VpcContainerAPI()
+	if err != nil {
+		return err
+	}
+	targetEnv, err := getVpcClusterTargetHeader(d, meta)
+	if err != nil {
+		return err
+	}
+	name := d.Get("cluster_name_id").(string)
+	admin := d.Get("admin").(bool)
+	endpointType := d.Get("endpoint_type").(string)
+
+	var credentials clusterCredentials
+	err = resource.Retry(5*time.Minute, func() *resource.RetryError {
+		var err error
+		credentials, err = getClusterCredentials(csClient, name, admin, endpointType, targetEnv)
+		if err != nil {
+			log.Printf("[DEBUG] Failed to fetch cluster credentials err %s", err)
+			if strings.Contains(err.Error(), "could not login to openshift account") {
+				return resource.RetryableError(err)
+			}
+			if intermittentUserLookupFailure, _ := regexp.MatchString("Error: lookup of user for \"(.+)\" failed", err.Error()); intermittentUserLookupFailure {
+				// Intermittent error resulting from synchronisation delay
+				return resource.RetryableError(err)
+			}
+			return resource.NonRetryableError(err)
+		}
+		return nil
+	})
+	if conns.IsResourceTimeoutError(err) {
+		credentials, err = getClusterCredentials(csClient, name, admin, endpointType, targetEnv)
+	}
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error fetching the credentials of cluster [%s]: %s", name, err)
+	}
+
+	d.SetId(name)
+	d.Set("host", credentials.Host)
+	d.Set("ca_certificate", credentials.CACertificate)
+	d.Set("token", credentials.Token)
+	d.Set("token_expiration", credentials.TokenExpiration)
+	d.Set("client_certificate", credentials.ClientCertificate)
+	d.Set("client_key", credentials.ClientKey)
+	return nil
+}
+
+// clusterCredentials holds the connection details of a cluster API endpoint.
+type clusterCredentials struct {
+	Host              string
+	CACertificate     string
+	Token             string
+	TokenExpiration   string
+	ClientCertificate string
+	ClientKey         string
+}
+
+// clusterConfigClient exposes the methods of the bluemix-go cluster client that are needed to build the
+// cluster config without writing it to disk.
+type clusterConfigClient interface {
+	FindWithOutShowResourcesCompatible(name string, target v2.ClusterTargetHeader) (v2.ClusterInfo, error)
+	FetchOCTokenForKubeConfig(kubecfg []byte, cMeta *v2.ClusterInfo, skipSSLVerification bool, endpointType string) ([]byte, string, error)
+}
+
+type containerAPIPoster interface {
+	Post(path string, data interface{}, respV interface{}, extraHeader ...interface{}) (*gohttp.Response, error)
+}
+
+// getClusterCredentials downloads the cluster config archive into memory and extracts the endpoint,
+// certificates and token from it. Unlike GetClusterConfigDetail nothing is written to the filesystem.
+func getClusterCredentials(csClient v2.ContainerServiceAPI, name string, admin bool, endpointType string, target v2.ClusterTargetHeader) (clusterCredentials, error) {
+	credentials := clusterCredentials{}
+	clusters, ok := csClient.Clusters().(clusterConfigClient)
+	if !ok {
+		return credentials, fmt.Errorf("[ERROR] The container client does not support fetching cluster configs")
+	}
+	poster, ok := csClient.(containerAPIPoster)
+	if !ok {
+		return credentials, fmt.Errorf("[ERROR] The container client does not support fetching cluster configs")
+	}
+
+	clusterInfo, err := clusters.FindWithOutShowResourcesCompatible(name, target)
+	if err != nil {
+		return credentials, err
+	}
+
+	postBody := map[string]interface{}{
+		"cluster": name,
+		"format":  "zip",
+	}
+	if admin {
+		postBody["admin"] = true
+	}
+	if clusterInfo.Provider == "satellite" {
+		postBody["endpointType"] = "link"
+		postBody["admin"] = true
+	} else if endpointType != "" {
+		postBody["endpointType"] = endpointType
+	}
+	archive := new(bytes.Buffer)
+	_, err = poster.Post("/v2/applyRBACAndGetKubeconfig", postBody, archive, target.ToMap())
+	if err != nil {
+		return credentials, err
+	}
+
+	zipReader, err := zip.NewReader(bytes.NewReader(archive.Bytes()), int64(archive.Len()))
+	if err != nil {
+		return credentials, fmt.Errorf("[ERROR] Error reading the cluster config archive: %s", err)
+	}
+	var kubeconfig []byte
+	for _, f := range zipReader.File {
+		fileName := path.Base(f.Name)
+		content, err := readClusterConfigArchiveFile(f)
+		if err != nil {
+			return credentials, err
+		}
+		switch {
+		case fileName == "admin-key.pem":
+			credentials.ClientKey = string(content)
+		case fileName == "admin.pem":
+			credentials.ClientCertificate = string(content)
+		case strings.HasPrefix(fileName, "ca") && strings.HasSuffix(fileName, ".pem"):
+			credentials.CACertificate = string(content)
+		case strings.HasSuffix(fileName, ".yaml") || strings.HasSuffix(fileName, ".yml"):
+			kubeconfig = content
+		}
+	}
+	if kubeconfig == nil {
+		return credentials, fmt.Errorf("[ERROR] Unable to locate kube config in zip archive")
+	}
+
+	var config v1.ConfigFile
+	if err := yaml.Unmarshal(kubeconfig, &config); err != nil {
+		return credentials, fmt.Errorf("[ERROR] Error parsing the cluster kube config: %s", err)
+	}
+	if len(config.Clusters) != 0 {
+		credentials.Host = config.Clusters[0].Cluster.Server
+	}
+	if len(config.Users) != 0 {
+		credentials.Token = config.Users[0].User.AuthProvider.Config.IDToken
+	}
+
+	if clusterInfo.Type == "openshift" && clusterInfo.Provider != "satellite" {
+		kubeconfig, credentials.Host, err = clusters.FetchOCTokenForKubeConfig(kubeconfig, &clusterInfo, clusterInfo.IsStagingSatelliteCluster(), endpointType)
+		if err != nil {
+			return credentials, err
+		}
+		var openshiftConfig v1.ConfigFileOpenshift
+		if err := yaml.Unmarshal(kubeconfig, &openshiftConfig); err != nil {
+			return credentials, fmt.Errorf("[ERROR] Error parsing the cluster kube config: %s", err)
+		}
+		for _, user := range openshiftConfig.Users {
+			if strings.HasPrefix(user.Name, "IAM") {
+				credentials.Token = user.User.Token
+			}
+		}
+		credentials.CACertificate = ""
+	}
+	credentials.TokenExpiration = clusterTokenExpiration(credentials.Token)
+	return credentials, nil
+}
+
+func readClusterConfigArchiveFile(f *zip.File) ([]byte, error) {
+	rc, err := f.Open()
+	if err != nil {
+		return nil, fmt.Errorf("[ERROR] Error reading %s from the cluster config archive: %s", f.Name, err)
+	}
+	defer rc.Close()
+	return io.ReadAll(rc)
+}
+
+// clusterTokenExpiration returns the expiration time of a JWT token in RFC 3339 format, or an empty string if
+// the token is not a JWT.
+func clusterTokenExpiration(token string) string {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return ""
+	}
+	payload, err := base64.RawURLEncoding.DecodeString(strings.TrimRight(parts[1], "="))
+	if err != nil {
+		return ""
+	}
+	claims := struct {
+		Exp int64 `json:"exp"`
+	}{}
+	if err := json.Unmarshal(payload, &claims); err != nil || claims.Exp == 0 {
+		return ""
+	}
+	return time.Unix(claims.Exp, 0).UTC().Format(time.RFC3339)
+}
@@ -0,0 +1,42 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package kubernetes_test
+
+import (
+	"fmt"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIBMContainer_ClusterCredentialsDataSourceBasic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMContainerClusterCredentialsDataSourceConfig(acc.ClusterName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(
+						"data.ibm_container_cluster_credentials.credentials", "host"),
+					resource.TestCheckResourceAttrSet(
+						"data.ibm_container_cluster_credentials.credentials", "client_certificate"),
+					resource.TestCheckResourceAttrSet(
+						"data.ibm_container_cluster_credentials.credentials", "client_key"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMContainerClusterCredentialsDataSourceConfig(cluster string) string {
+	return fmt.Sprintf(`
+	data "ibm_container_cluster_credentials" "credentials" {
+		cluster_name_id = "%s"
+		admin           = true
+	}
+	`, cluster)
+}
@@ -0,0 +1,377 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package kubernetes
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	v1 "github.com/IBM-Cloud/bluemix-go/api/container/containerv1"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	goversion "github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func DataSourceIBMContainerClusterUpgradePlan() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceIBMContainerClusterUpgradePlanRead,
+
+		Schema: map[string]*schema.Schema{
+			"cluster_name_id": {
+				Description: "The name/id of the cluster",
+				Type:        schema.TypeString,
+				Required:    true,
+				ValidateFunc: validate.InvokeDataSourceValidator(
+					"ibm_container_cluster_upgrade_plan",
+					"cluster_name_id"),
+			},
+			"resource_group_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "ID of the resource group.",
+			},
+			"target_version": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "The major.minor version to plan the upgrade to. Defaults to the latest supported version",
+			},
+			"current_version": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The current version of the cluster master",
+			},
+			"upgrade_path": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The ordered list of versions the cluster master is upgraded to",
+			},
+			"blocked": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether any step of the upgrade path is blocked",
+			},
+			"steps": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The steps of the upgrade path",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"version": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The version the cluster master is upgraded to",
+						},
+						"blocked": {
+							Type:        schema.TypeBool,
+							Computed:    true,
+							Description: "Whether the step is blocked",
+						},
+						"blockers": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "The reasons that block the step",
+						},
+					},
+				},
+			},
+			"lagging_workers": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The workers that run an older major.minor version than the cluster master",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The ID of the worker",
+						},
+						"pool_name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The name of the worker pool",
+						},
+						"actual_version": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The version the worker runs",
+						},
+						"target_version": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The version the worker can be updated to",
+						},
+					},
+				},
+			},
+			"addon_blockers": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The addons that do not support a version of the upgrade path",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The name of the addon",
+						},
+						"version": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The installed version of the addon",
+						},
+						"supported_kube_range": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The Kubernetes versions that are supported by the installed addon version",
+						},
+						"allowed_upgrade_versions": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "The addon versions the installed version can be upgraded to",
+						},
+						"blocked_version": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The first version of the upgrade path that is not supported by the addon",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func DataSourceIBMContainerClusterUpgradePlanValidator() *validate.ResourceValidator {
+	validateSchema := make([]validate.ValidateSchema, 0)
+	validateSchema = append(validateSchema,
+		validate.ValidateSchema{
+			Identifier:                 "cluster_name_id",
+			ValidateFunctionIdentifier: validate.ValidateCloudData,
+			Type:                       validate.TypeString,
+			Required:                   true,
+			CloudDataType:              "cluster",
+			CloudDataRange:             []string{"resolved_to:id"}})
+
+	iBMContainerClusterUpgradePlanValidator := validate.ResourceValidator{ResourceName: "ibm_container_cluster_upgrade_plan", Schema: validateSchema}
+	return &iBMContainerClusterUpgradePlanValidator
+}
+
+var kubeRangeConstraintRegexp = regexp.MustCompile(`(>=|<=|!=|~>|>|<|=)?\s*v?[0-9][0-9A-Za-z.\-]*`)
+
+// parseClusterVersion parses a cluster version such as 1.28.4_1541 or 4.14.6_1544_openshift.
+func parseClusterVersion(version string) (*goversion.Version, error) {
+	return goversion.NewVersion(strings.SplitN(version, "_", 2)[0])
+}
+
+// parseKubeRange converts a supported kube range such as ">=1.26.0 <1.30.0" into version constraints.
+func parseKubeRange(kubeRange string) (goversion.Constraints, error) {
+	constraints := kubeRangeConstraintRegexp.FindAllString(kubeRange, -1)
+	if len(constraints) == 0 {
+		return nil, fmt.Errorf("[ERROR] Invalid kube range %q", kubeRange)
+	}
+	return goversion.NewConstraint(strings.Join(constraints, ","))
+}
+
+func majorMinor(version *goversion.Version) string {
+	segments := version.Segments()
+	return fmt.Sprintf("%d.%d", segments[0], segments[1])
+}
+
+func dataSourceIBMContainerClusterUpgradePlanRead(d *schema.ResourceData, meta interface{}) error {
+	csClient, err := meta.(conns.ClientSession).VpcContainerAPI()
+	if err != nil {
+		return err
+	}
+	csClientV1, err := meta.(conns.ClientSession).ContainerAPI()
+	if err != nil {
+		return err
+	}
+	targetEnv, err := getVpcClusterTargetHeader(d, meta)
+	if err != nil {
+		return err
+	}
+	targetEnvV1, err := getClusterTargetHeader(d, meta)
+	if err != nil {
+		return err
+	}
+	name := d.Get("cluster_name_id").(string)
+
+	cluster, err := csClient.Clusters().GetCluster(name, targetEnv)
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error retrieving cluster %s: %s", name, err)
+	}
+	workers, err := csClient.Workers().ListWorkers(name, false, targetEnv)
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error retrieving workers for cluster %s: %s", name, err)
+	}
+	addOns, err := csClientV1.AddOns().GetAddons(name, targetEnvV1)
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error retrieving addons for cluster %s: %s", name, err)
+	}
+	availableVersions, err := csClientV1.KubeVersions().ListV1(targetEnvV1)
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error retrieving the available versions: %s", err)
+	}
+
+	current, err := parseClusterVersion(cluster.MasterKubeVersion)
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error parsing the version %s of cluster %s: %s", cluster.MasterKubeVersion, name, err)
+	}
+
+	platform := "kubernetes"
+	if cluster.Type == "openshift" {
+		platform = "openshift"
+	}
+	path, err := clusterUpgradePath(current, availableVersions[platform], d.Get("target_version").(string))
+	if err != nil {
+		return err
+	}
+
+	laggingWorkers := make([]map[string]interface{}, 0)
+	for _, worker := range workers {
+		actual, err := parseClusterVersion(worker.KubeVersion.Actual)
+		if err != nil {
+			continue
+		}
+		if majorMinor(actual) != majorMinor(current) {
+			laggingWorkers = append(laggingWorkers, map[string]interface{}{
+				"id":             worker.ID,
+				"pool_name":      worker.PoolName,
+				"actual_version": worker.KubeVersion.Actual,
+				"target_version": worker.KubeVersion.Target,
+			})
+		}
+	}
+
+	addonBlockers := make([]map[string]interface{}, 0)
+	blockedByAddon := make(map[string][]string)
+	for _, addOn := range addOns {
+		// The supported kube range of an addon is expressed in Kubernetes versions, it is only checked for
+		// Kubernetes clusters.
+		if addOn.SupportedKubeRange == "" || platform != "kubernetes" {
+			continue
+		}
+		constraints, err := parseKubeRange(addOn.SupportedKubeRange)
+		if err != nil {
+			continue
+		}
+		for _, step := range path {
+			if !constraints.Check(step) {
+				addonBlockers = append(addonBlockers, map[string]interface{}{
+					"name":                     addOn.Name,
+					"version":                  addOn.Version,
+					"supported_kube_range":     addOn.SupportedKubeRange,
+					"allowed_upgrade_versions": addOn.AllowedUpgradeVersion,
+					"blocked_version":          step.String(),
+				})
+				blockedByAddon[step.String()] = append(blockedByAddon[step.String()], clusterUpgradeAddonBlocker(addOn))
+				break
+			}
+		}
+	}
+
+	upgradePath := make([]string, 0, len(path))
+	steps := make([]map[string]interface{}, 0, len(path))
+	blocked := false
+	for i, step := range path {
+		blockers := blockedByAddon[step.String()]
+		if i == 0 {
+			// Workers that already run an older version than the master would fall further behind, they
+			// are updated before the master is upgraded.
+			for _, worker := range laggingWorkers {
+				blockers = append(blockers, fmt.Sprintf("worker %s in pool %s runs version %s, update the worker to the master version first", worker["id"], worker["pool_name"], worker["actual_version"]))
+			}
+		}
+		if blockers == nil {
+			blockers = []string{}
+		}
+		blocked = blocked || len(blockers) > 0
+		upgradePath = append(upgradePath, step.String())
+		steps = append(steps, map[string]interface{}{
+			"version":  step.String(),
+			"blocked":  len(blockers) > 0,
+			"blockers": blockers,
+		})
+	}
+
+	d.SetId(cluster.ID)
+	d.Set("current_version", cluster.MasterKubeVersion)
+	if len(path) > 0 {
+		d.Set("target_version", majorMinor(path[len(path)-1]))
+	} else {
+		d.Set("target_version", majorMinor(current))
+	}
+	d.Set("upgrade_path", upgradePath)
+	d.Set("blocked", blocked)
+	d.Set("steps", steps)
+	d.Set("lagging_workers", laggingWorkers)
+	d.Set("addon_blockers", addonBlockers)
+	return nil
+}
+
+func clusterUpgradeAddonBlocker(addOn v1.AddOn) string {
+	if len(addOn.AllowedUpgradeVersion) > 0 {
+		return fmt.Sprintf("addon %s version %s supports %s, upgrade the addon to one of %s first", addOn.Name, addOn.Version, addOn.SupportedKubeRange, strings.Join(addOn.AllowedUpgradeVersion, ", "))
+	}
+	return fmt.Sprintf("addon %s version %s supports %s and has no upgrade available", addOn.Name, addOn.Version, addOn.SupportedKubeRange)
+}
+
+// clusterUpgradePath returns the latest patch version of every minor version between the current version and the
+// target version. The master is upgraded one minor version at a time. If the target version is empty the latest
+// available version is used.
+func clusterUpgradePath(current *goversion.Version, available []v1.KubeVersion, target string) ([]*goversion.Version, error) {
+	latestByMinor := make(map[string]*goversion.Version)
+	for _, kubeVersion := range available {
+		version, err := goversion.NewVersion(fmt.Sprintf("%d.%d.%d", kubeVersion.Major, kubeVersion.Minor, kubeVersion.Patch))
+		if err != nil {
+			continue
+		}
+		minor := majorMinor(version)
+		if latest, ok := latestByMinor[minor]; !ok || version.GreaterThan(latest) {
+			latestByMinor[minor] = version
+		}
+	}
+	versions := make([]*goversion.Version, 0, len(latestByMinor))
+	for _, version := range latestByMinor {
+		versions = append(versions, version)
+	}
+	sort.Sort(goversion.Collection(versions))
+
+	var targetVersion *goversion.Version
+	if target != "" {
+		var err error
+		targetVersion, err = goversion.NewVersion(target)
+		if err != nil {
+			return nil, fmt.Errorf("[ERROR] Invalid target version %s: %s", target, err)
+		}
+		if _, ok := latestByMinor[majorMinor(targetVersion)]; !ok && majorMinor(targetVersion) != majorMinor(current) {
+			return nil, fmt.Errorf("[ERROR] The target version %s is not an available version", target)
+		}
+		if majorMinor(targetVersion) != majorMinor(current) && targetVersion.LessThan(current) {
+			return nil, fmt.Errorf("[ERROR] The target version %s is older than the current version %s", target, current)
+		}
+	}
+
+	path := make([]*goversion.Version, 0)
+	for _, version := range versions {
+		if majorMinor(version) == majorMinor(current) || version.LessThan(current) {
+			continue
+		}
+		if targetVersion != nil && majorMinor(version) != majorMinor(targetVersion) && version.GreaterThan(targetVersion) {
+			break
+		}
+		path = append(path, version)
+		if targetVersion != nil && majorMinor(version) == majorMinor(targetVersion) {
+			break
+		}
+	}
+	return path, nil
+}
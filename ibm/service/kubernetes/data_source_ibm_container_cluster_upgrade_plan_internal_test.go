@@ -0,0 +1,138 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package kubernetes
+
+import (
+	"testing"
+
+	v1 "github.com/IBM-Cloud/bluemix-go/api/container/containerv1"
+	goversion "github.com/hashicorp/go-version"
+	"gotest.tools/assert"
+)
+
+func TestParseKubeRange(t *testing.T) {
+	testcases := []struct {
+		kubeRange     string
+		expectedError bool
+		matches       map[string]bool
+	}{
+		{
+			kubeRange: ">=1.26.0 <1.30.0",
+			matches:   map[string]bool{"1.25.9": false, "1.26.0": true, "1.29.9": true, "1.30.0": false},
+		},
+		{
+			kubeRange: ">= 1.27.0, < 1.29.0",
+			matches:   map[string]bool{"1.26.15": false, "1.27.0": true, "1.28.6": true, "1.29.0": false},
+		},
+		{
+			kubeRange: "1.28.4",
+			matches:   map[string]bool{"1.28.4": true, "1.28.5": false},
+		},
+		{
+			kubeRange: ">v1.27.2",
+			matches:   map[string]bool{"1.27.2": false, "1.27.3": true},
+		},
+		{
+			kubeRange:     "",
+			expectedError: true,
+		},
+		{
+			kubeRange:     "any",
+			expectedError: true,
+		},
+	}
+	for _, tc := range testcases {
+		constraints, err := parseKubeRange(tc.kubeRange)
+		if tc.expectedError {
+			assert.Assert(t, err != nil, "kube range %q", tc.kubeRange)
+			continue
+		}
+		assert.NilError(t, err, "kube range %q", tc.kubeRange)
+		for version, expected := range tc.matches {
+			assert.Equal(t, constraints.Check(goversion.Must(goversion.NewVersion(version))), expected, "kube range %q, version %s", tc.kubeRange, version)
+		}
+	}
+}
+
+func TestClusterUpgradePath(t *testing.T) {
+	// 1.28 is not available, so an upgrade from 1.27 goes to 1.29
+	available := []v1.KubeVersion{
+		{Major: 1, Minor: 25, Patch: 16},
+		{Major: 1, Minor: 26, Patch: 9},
+		{Major: 1, Minor: 27, Patch: 5},
+		{Major: 1, Minor: 27, Patch: 8},
+		{Major: 1, Minor: 29, Patch: 2},
+		{Major: 1, Minor: 30, Patch: 1},
+	}
+	testcases := []struct {
+		current       string
+		target        string
+		expected      []string
+		expectedError string
+	}{
+		{
+			current:  "1.26.3",
+			target:   "",
+			expected: []string{"1.27.8", "1.29.2", "1.30.1"},
+		},
+		{
+			current:  "1.26.3",
+			target:   "1.29.2",
+			expected: []string{"1.27.8", "1.29.2"},
+		},
+		{
+			current:  "1.26.3",
+			target:   "1.29.0",
+			expected: []string{"1.27.8", "1.29.2"},
+		},
+		{
+			current:  "1.26.3",
+			target:   "1.27",
+			expected: []string{"1.27.8"},
+		},
+		{
+			current:  "1.26.3",
+			target:   "1.26.9",
+			expected: []string{},
+		},
+		{
+			current:  "1.30.1",
+			target:   "",
+			expected: []string{},
+		},
+		{
+			current:       "1.26.3",
+			target:        "1.28.4",
+			expectedError: "[ERROR] The target version 1.28.4 is not an available version",
+		},
+		{
+			current:       "1.26.3",
+			target:        "1.31.0",
+			expectedError: "[ERROR] The target version 1.31.0 is not an available version",
+		},
+		{
+			current:       "1.26.3",
+			target:        "1.25.16",
+			expectedError: "[ERROR] The target version 1.25.16 is older than the current version 1.26.3",
+		},
+		{
+			current:       "1.26.3",
+			target:        "latest",
+			expectedError: "[ERROR] Invalid target version latest: Malformed version: latest",
+		},
+	}
+	for _, tc := range testcases {
+		path, err := clusterUpgradePath(goversion.Must(goversion.NewVersion(tc.current)), available, tc.target)
+		if tc.expectedError != "" {
+			assert.Error(t, err, tc.expectedError)
+			continue
+		}
+		assert.NilError(t, err, "current %s, target %q", tc.current, tc.target)
+		versions := make([]string, 0, len(path))
+		for _, version := range path {
+			versions = append(versions, version.String())
+		}
+		assert.DeepEqual(t, versions, tc.expected)
+	}
+}
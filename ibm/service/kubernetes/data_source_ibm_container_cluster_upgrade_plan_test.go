@@ -0,0 +1,40 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package kubernetes_test
+
+import (
+	"fmt"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIBMContainerClusterUpgradePlanDataSource_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMContainerClusterUpgradePlanDataSource(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(
+						"data.ibm_container_cluster_upgrade_plan.plan", "current_version"),
+					resource.TestCheckResourceAttrSet(
+						"data.ibm_container_cluster_upgrade_plan.plan", "target_version"),
+					resource.TestCheckResourceAttrSet(
+						"data.ibm_container_cluster_upgrade_plan.plan", "blocked"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMContainerClusterUpgradePlanDataSource() string {
+	return fmt.Sprintf(`
+data "ibm_container_cluster_upgrade_plan" "plan" {
+  cluster_name_id = "%s"
+}
+`, acc.ClusterName)
+}
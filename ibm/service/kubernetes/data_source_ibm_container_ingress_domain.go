@@ -0,0 +1,117 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package kubernetes
+
+import (
+	"fmt"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func DataSourceIBMContainerIngressDomain() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceIBMContainerIngressDomainRead,
+		Schema: map[string]*schema.Schema{
+			"cluster": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Cluster ID or name",
+				ValidateFunc: validate.InvokeValidator(
+					"ibm_container_ingress_domain",
+					"cluster"),
+			},
+			"domain": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The domain name",
+			},
+			"dns_provider": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The DNS provider of the domain",
+			},
+			"dns_provider_crn": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The CRN of the DNS provider instance",
+			},
+			"domain_zone": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The zone of the DNS provider that the domain belongs to",
+			},
+			"is_default": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether the domain is the default domain of the cluster",
+			},
+			"ip_addresses": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The IP addresses the domain is registered with",
+			},
+			"lb_hostname": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The load balancer hostname the domain is registered with",
+			},
+			"secret_namespace": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The namespace of the TLS secret that is bound to the domain",
+			},
+			"secret_name": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The name of the TLS secret that is bound to the domain",
+			},
+			"secret_status": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The status of the TLS secret that is bound to the domain",
+			},
+			"status": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The status of the domain",
+			},
+		},
+	}
+}
+
+func dataSourceIBMContainerIngressDomainRead(d *schema.ResourceData, meta interface{}) error {
+	client, err := getContainerAPIRawClient(meta)
+	if err != nil {
+		return err
+	}
+	targetEnv, err := getVpcClusterTargetHeader(d, meta)
+	if err != nil {
+		return err
+	}
+
+	cluster := d.Get("cluster").(string)
+	domain := d.Get("domain").(string)
+
+	ingressDomain, err := getIngressDomain(client, cluster, domain, targetEnv)
+	if err != nil {
+		return err
+	}
+
+	d.Set("dns_provider", ingressDomain.DNSProvider)
+	d.Set("dns_provider_crn", ingressDomain.DNSProviderCRN)
+	d.Set("domain_zone", ingressDomain.DomainZone)
+	d.Set("is_default", ingressDomain.IsDefault)
+	d.Set("ip_addresses", ingressDomain.IPAddresses)
+	d.Set("lb_hostname", ingressDomain.LBHostname)
+	d.Set("secret_namespace", ingressDomain.SecretNamespace)
+	d.Set("secret_name", ingressDomain.SecretName)
+	d.Set("secret_status", ingressDomain.SecretStatus)
+	d.Set("status", ingressDomain.Status)
+
+	d.SetId(fmt.Sprintf("%s/%s", cluster, domain))
+
+	return nil
+}
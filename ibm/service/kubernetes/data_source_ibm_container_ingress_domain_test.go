@@ -0,0 +1,40 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package kubernetes_test
+
+import (
+	"fmt"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIBMContainerIngressDomainDatasourceBasic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMContainerIngressDomainDataSourceConfig(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.ibm_container_ingress_domain.test_ds_domain", "dns_provider", "akamai"),
+					resource.TestCheckResourceAttrSet("data.ibm_container_ingress_domain.test_ds_domain", "status"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMContainerIngressDomainDataSourceConfig() string {
+	return fmt.Sprintf(`
+	resource "ibm_container_ingress_domain" "test_acc_domain" {
+		cluster      = "%s"
+		dns_provider = "akamai"
+	}
+	data "ibm_container_ingress_domain" "test_ds_domain" {
+		cluster = ibm_container_ingress_domain.test_acc_domain.cluster
+		domain  = ibm_container_ingress_domain.test_acc_domain.domain
+	}`, acc.ClusterName)
+}
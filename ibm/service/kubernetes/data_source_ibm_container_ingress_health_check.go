@@ -0,0 +1,147 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package kubernetes
+
+import (
+	"fmt"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func DataSourceIBMContainerIngressHealthCheck() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceIBMContainerIngressHealthCheckRead,
+		Schema: map[string]*schema.Schema{
+			"cluster": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Cluster ID or name",
+				ValidateFunc: validate.InvokeValidator(
+					"ibm_container_ingress_health_check",
+					"cluster"),
+			},
+			"domain": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The ingress domain that is monitored",
+			},
+			"enabled": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether the health check is enabled",
+			},
+			"type": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The protocol of the health check",
+			},
+			"method": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The HTTP method of the health check",
+			},
+			"path": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The path that is requested by the health check",
+			},
+			"port": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The port that is requested by the health check",
+			},
+			"interval": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The interval between health checks in seconds",
+			},
+			"timeout": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The timeout of a health check in seconds",
+			},
+			"retries": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The number of retries before the domain is marked unhealthy",
+			},
+			"expected_codes": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The expected HTTP response code or code range",
+			},
+			"expected_body": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "A case insensitive substring that is expected in the response body",
+			},
+			"headers": {
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The HTTP request headers of the health check",
+			},
+			"follow_redirects": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether redirects are followed",
+			},
+			"allow_insecure": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether the certificate of the domain is not validated",
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The description of the health check",
+			},
+			"status": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The status of the health check",
+			},
+		},
+	}
+}
+
+func dataSourceIBMContainerIngressHealthCheckRead(d *schema.ResourceData, meta interface{}) error {
+	client, err := getContainerAPIRawClient(meta)
+	if err != nil {
+		return err
+	}
+	targetEnv, err := getVpcClusterTargetHeader(d, meta)
+	if err != nil {
+		return err
+	}
+
+	cluster := d.Get("cluster").(string)
+	domain := d.Get("domain").(string)
+
+	healthCheck, err := getIngressHealthCheck(client, cluster, domain, targetEnv)
+	if err != nil {
+		return err
+	}
+
+	d.Set("enabled", healthCheck.Enabled)
+	d.Set("type", healthCheck.Type)
+	d.Set("method", healthCheck.Method)
+	d.Set("path", healthCheck.Path)
+	d.Set("port", healthCheck.Port)
+	d.Set("interval", healthCheck.Interval)
+	d.Set("timeout", healthCheck.Timeout)
+	d.Set("retries", healthCheck.Retries)
+	d.Set("expected_codes", healthCheck.ExpectedCodes)
+	d.Set("expected_body", healthCheck.ExpectedBody)
+	d.Set("headers", flattenIngressHealthCheckHeaders(healthCheck.Headers))
+	d.Set("follow_redirects", healthCheck.FollowRedirects)
+	d.Set("allow_insecure", healthCheck.AllowInsecure)
+	d.Set("description", healthCheck.Description)
+	d.Set("status", healthCheck.Status)
+
+	d.SetId(fmt.Sprintf("%s/%s", cluster, domain))
+
+	return nil
+}
@@ -0,0 +1,45 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package kubernetes_test
+
+import (
+	"fmt"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIBMContainerIngressHealthCheckDatasourceBasic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMContainerIngressHealthCheckDataSourceConfig(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.ibm_container_ingress_health_check.test_ds_health_check", "path", "/healthz"),
+					resource.TestCheckResourceAttr("data.ibm_container_ingress_health_check.test_ds_health_check", "enabled", "true"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMContainerIngressHealthCheckDataSourceConfig() string {
+	return fmt.Sprintf(`
+	resource "ibm_container_ingress_domain" "test_acc_domain" {
+		cluster      = "%s"
+		dns_provider = "akamai"
+	}
+	resource "ibm_container_ingress_health_check" "test_acc_health_check" {
+		cluster = ibm_container_ingress_domain.test_acc_domain.cluster
+		domain  = ibm_container_ingress_domain.test_acc_domain.domain
+		path    = "/healthz"
+	}
+	data "ibm_container_ingress_health_check" "test_ds_health_check" {
+		cluster = ibm_container_ingress_health_check.test_acc_health_check.cluster
+		domain  = ibm_container_ingress_health_check.test_acc_health_check.domain
+	}`, acc.ClusterName)
+}
@@ -53,6 +53,30 @@ func DataSourceIBMContainerVpcClusterWorkerPool() *schema.Resource {
 				Computed: true,
 				Elem:     &schema.Schema{Type: schema.TypeString},
 			},
+			"taints": {
+				Type:        schema.TypeSet,
+				Computed:    true,
+				Description: "WorkerPool Taints",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"key": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Key for taint",
+						},
+						"value": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Value for taint.",
+						},
+						"effect": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Effect for taint.",
+						},
+					},
+				},
+			},
 			"operating_system": {
 				Type:        schema.TypeString,
 				Computed:    true,
@@ -177,6 +201,7 @@ func dataSourceIBMContainerVpcClusterWorkerPoolRead(d *schema.ResourceData, meta
 	d.Set("flavor", workerPool.Flavor)
 	d.Set("worker_count", workerPool.WorkerCount)
 	d.Set("labels", workerPool.Labels)
+	d.Set("taints", flattenWorkerPoolTaints(workerPool))
 	d.Set("operating_system", workerPool.OperatingSystem)
 	d.Set("zones", zones)
 	d.Set("cluster", clusterName)
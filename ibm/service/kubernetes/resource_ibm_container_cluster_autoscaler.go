@@ -0,0 +1,432 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package kubernetes
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+
+	v2 "github.com/IBM-Cloud/bluemix-go/api/container/containerv2"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+)
+
+const (
+	clusterAutoscalerNamespace     = "kube-system"
+	clusterAutoscalerConfigMap     = "iks-ca-configmap"
+	clusterAutoscalerWorkerPoolKey = "workerPoolsConfig.json"
+)
+
+// clusterAutoscalerParameters maps the global autoscaler arguments to their keys in the autoscaler ConfigMap.
+var clusterAutoscalerParameters = map[string]string{
+	"expander":                         "expander",
+	"max_node_provision_time":          "maxNodeProvisionTime",
+	"scale_down_delay_after_add":       "scaleDownDelayAfterAdd",
+	"scale_down_delay_after_delete":    "scaleDownDelayAfterDelete",
+	"scale_down_unneeded_time":         "scaleDownUnneededTime",
+	"scale_down_utilization_threshold": "scaleDownUtilizationThreshold",
+	"scan_interval":                    "scanInterval",
+}
+
+// clusterAutoscalerBoolParameters maps the global boolean autoscaler arguments to their keys in the autoscaler ConfigMap.
+var clusterAutoscalerBoolParameters = map[string]string{
+	"ignore_daemonsets_utilization": "ignoreDaemonSetsUtilization",
+	"skip_nodes_with_local_storage": "skipNodesWithLocalStorage",
+	"skip_nodes_with_system_pods":   "skipNodesWithSystemPods",
+}
+
+type clusterAutoscalerWorkerPool struct {
+	Name    string `json:"name"`
+	MinSize int    `json:"minSize"`
+	MaxSize int    `json:"maxSize"`
+	Enabled bool   `json:"enabled"`
+}
+
+func ResourceIBMContainerClusterAutoscaler() *schema.Resource {
+	return &schema.Resource{
+		Create:   resourceIBMContainerClusterAutoscalerCreate,
+		Read:     resourceIBMContainerClusterAutoscalerRead,
+		Update:   resourceIBMContainerClusterAutoscalerUpdate,
+		Delete:   resourceIBMContainerClusterAutoscalerDelete,
+		Importer: &schema.ResourceImporter{},
+
+		Schema: map[string]*schema.Schema{
+			"cluster": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Cluster Name or ID",
+				ValidateFunc: validate.InvokeValidator(
+					"ibm_container_cluster_autoscaler",
+					"cluster"),
+			},
+			"resource_group_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: "ID of the resource group.",
+			},
+			"endpoint_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validate.InvokeValidator("ibm_container_cluster_autoscaler", "endpoint_type"),
+				Description:  "The type of the cluster API endpoint that is used to manage the autoscaler ConfigMap",
+			},
+			"worker_pool": {
+				Type:        schema.TypeList,
+				Required:    true,
+				MinItems:    1,
+				Description: "Autoscaling settings of the worker pools. Worker pools that are not listed are not autoscaled",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "The name of the worker pool",
+						},
+						"min_size": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validate.InvokeValidator("ibm_container_cluster_autoscaler", "min_size"),
+							Description:  "The minimum number of worker nodes per zone",
+						},
+						"max_size": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validate.InvokeValidator("ibm_container_cluster_autoscaler", "max_size"),
+							Description:  "The maximum number of worker nodes per zone",
+						},
+						"enabled": {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Default:     true,
+							Description: "Whether the worker pool is autoscaled",
+						},
+					},
+				},
+			},
+			"scan_interval": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "How often the cluster is reevaluated for scale up or down, for example `1m`",
+			},
+			"expander": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validate.InvokeValidator("ibm_container_cluster_autoscaler", "expander"),
+				Description:  "How the worker pool to scale up is selected when several worker pools match",
+			},
+			"max_node_provision_time": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "The maximum time a worker node can take to provision before the scale up is cancelled, for example `120m`",
+			},
+			"scale_down_delay_after_add": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "How long after a scale up the scale down evaluation resumes, for example `10m`",
+			},
+			"scale_down_delay_after_delete": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "How long after a worker node deletion the scale down evaluation resumes, for example `10m`",
+			},
+			"scale_down_unneeded_time": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "How long a worker node must be unneeded before it is scaled down, for example `10m`",
+			},
+			"scale_down_utilization_threshold": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "The resource utilization below which a worker node is considered for scale down, for example `0.5`",
+			},
+			"ignore_daemonsets_utilization": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Computed:    true,
+				Description: "Whether daemon set pods are ignored when calculating the utilization of a worker node for scale down",
+			},
+			"skip_nodes_with_local_storage": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Computed:    true,
+				Description: "Whether worker nodes that run pods with local storage are never scaled down",
+			},
+			"skip_nodes_with_system_pods": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Computed:    true,
+				Description: "Whether worker nodes that run kube-system pods are never scaled down",
+			},
+		},
+	}
+}
+
+func ResourceIBMContainerClusterAutoscalerValidator() *validate.ResourceValidator {
+	validateSchema := make([]validate.ValidateSchema, 0)
+	validateSchema = append(validateSchema,
+		validate.ValidateSchema{
+			Identifier:                 "cluster",
+			ValidateFunctionIdentifier: validate.ValidateCloudData,
+			Type:                       validate.TypeString,
+			Required:                   true,
+			CloudDataType:              "cluster",
+			CloudDataRange:             []string{"resolved_to:id"}},
+		validate.ValidateSchema{
+			Identifier:                 "endpoint_type",
+			ValidateFunctionIdentifier: validate.ValidateAllowedStringValue,
+			Type:                       validate.TypeString,
+			Optional:                   true,
+			AllowedValues:              "private,link,vpe"},
+		validate.ValidateSchema{
+			Identifier:                 "min_size",
+			ValidateFunctionIdentifier: validate.IntAtLeast,
+			Type:                       validate.TypeInt,
+			Required:                   true,
+			MinValue:                   "0"},
+		validate.ValidateSchema{
+			Identifier:                 "max_size",
+			ValidateFunctionIdentifier: validate.IntAtLeast,
+			Type:                       validate.TypeInt,
+			Required:                   true,
+			MinValue:                   "1"},
+		validate.ValidateSchema{
+			Identifier:                 "expander",
+			ValidateFunctionIdentifier: validate.ValidateAllowedStringValue,
+			Type:                       validate.TypeString,
+			Optional:                   true,
+			AllowedValues:              "random,least-waste,most-pods,priority"})
+
+	iBMContainerClusterAutoscalerValidator := validate.ResourceValidator{ResourceName: "ibm_container_cluster_autoscaler", Schema: validateSchema}
+	return &iBMContainerClusterAutoscalerValidator
+}
+
+func resourceIBMContainerClusterAutoscalerCreate(d *schema.ResourceData, meta interface{}) error {
+	cluster := d.Get("cluster").(string)
+	if err := updateClusterAutoscalerConfig(d, meta, cluster); err != nil {
+		return err
+	}
+	d.SetId(cluster)
+	return resourceIBMContainerClusterAutoscalerRead(d, meta)
+}
+
+func resourceIBMContainerClusterAutoscalerRead(d *schema.ResourceData, meta interface{}) error {
+	cluster := d.Id()
+	clientset, err := getClusterKubeClientset(d, meta, cluster)
+	if err != nil {
+		return err
+	}
+	configMap, err := clientset.CoreV1().ConfigMaps(clusterAutoscalerNamespace).Get(context.TODO(), clusterAutoscalerConfigMap, metav1.GetOptions{})
+	if err != nil {
+		if k8serrors.IsNotFound(err) {
+			log.Printf("[WARN] Autoscaler ConfigMap of cluster %s not found, the cluster-autoscaler add-on is not installed", cluster)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("[ERROR] Error retrieving the autoscaler ConfigMap of cluster (%s): %s", cluster, err)
+	}
+
+	workerPools := []clusterAutoscalerWorkerPool{}
+	if poolsConfig, ok := configMap.Data[clusterAutoscalerWorkerPoolKey]; ok && poolsConfig != "" {
+		if err := json.Unmarshal([]byte(poolsConfig), &workerPools); err != nil {
+			return fmt.Errorf("[ERROR] Error parsing %s of the autoscaler ConfigMap of cluster (%s): %s", clusterAutoscalerWorkerPoolKey, cluster, err)
+		}
+	}
+	pools := make([]map[string]interface{}, 0, len(workerPools))
+	for _, pool := range workerPools {
+		pools = append(pools, map[string]interface{}{
+			"name":     pool.Name,
+			"min_size": pool.MinSize,
+			"max_size": pool.MaxSize,
+			"enabled":  pool.Enabled,
+		})
+	}
+
+	d.Set("cluster", cluster)
+	d.Set("worker_pool", pools)
+	for arg, key := range clusterAutoscalerParameters {
+		if value, ok := configMap.Data[key]; ok {
+			d.Set(arg, value)
+		}
+	}
+	for arg, key := range clusterAutoscalerBoolParameters {
+		if value, ok := configMap.Data[key]; ok {
+			if enabled, err := strconv.ParseBool(value); err == nil {
+				d.Set(arg, enabled)
+			}
+		}
+	}
+	return nil
+}
+
+func resourceIBMContainerClusterAutoscalerUpdate(d *schema.ResourceData, meta interface{}) error {
+	if err := updateClusterAutoscalerConfig(d, meta, d.Id()); err != nil {
+		return err
+	}
+	return resourceIBMContainerClusterAutoscalerRead(d, meta)
+}
+
+// resourceIBMContainerClusterAutoscalerDelete disables autoscaling on the managed worker pools. The global
+// parameters are left in place as they only take effect for autoscaled worker pools.
+func resourceIBMContainerClusterAutoscalerDelete(d *schema.ResourceData, meta interface{}) error {
+	cluster := d.Id()
+	clientset, err := getClusterKubeClientset(d, meta, cluster)
+	if err != nil {
+		return err
+	}
+	configMaps := clientset.CoreV1().ConfigMaps(clusterAutoscalerNamespace)
+	configMap, err := configMaps.Get(context.TODO(), clusterAutoscalerConfigMap, metav1.GetOptions{})
+	if err != nil {
+		if k8serrors.IsNotFound(err) {
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("[ERROR] Error retrieving the autoscaler ConfigMap of cluster (%s): %s", cluster, err)
+	}
+
+	workerPools := expandClusterAutoscalerWorkerPools(d)
+	for i := range workerPools {
+		workerPools[i].Enabled = false
+	}
+	poolsConfig, err := json.Marshal(workerPools)
+	if err != nil {
+		return err
+	}
+	if configMap.Data == nil {
+		configMap.Data = map[string]string{}
+	}
+	configMap.Data[clusterAutoscalerWorkerPoolKey] = string(poolsConfig)
+	if _, err := configMaps.Update(context.TODO(), configMap, metav1.UpdateOptions{}); err != nil {
+		return fmt.Errorf("[ERROR] Error disabling autoscaling in cluster (%s): %s", cluster, err)
+	}
+	d.SetId("")
+	return nil
+}
+
+// updateClusterAutoscalerConfig writes the worker pool settings and the configured global parameters to the
+// autoscaler ConfigMap of the cluster.
+func updateClusterAutoscalerConfig(d *schema.ResourceData, meta interface{}, cluster string) error {
+	workerPools := expandClusterAutoscalerWorkerPools(d)
+	for _, pool := range workerPools {
+		if pool.MaxSize < pool.MinSize {
+			return fmt.Errorf("[ERROR] max_size (%d) of worker pool %s must not be lower than min_size (%d)", pool.MaxSize, pool.Name, pool.MinSize)
+		}
+	}
+	poolsConfig, err := json.Marshal(workerPools)
+	if err != nil {
+		return err
+	}
+
+	clientset, err := getClusterKubeClientset(d, meta, cluster)
+	if err != nil {
+		return err
+	}
+	configMaps := clientset.CoreV1().ConfigMaps(clusterAutoscalerNamespace)
+	configMap, err := configMaps.Get(context.TODO(), clusterAutoscalerConfigMap, metav1.GetOptions{})
+	if err != nil {
+		if k8serrors.IsNotFound(err) {
+			return fmt.Errorf("[ERROR] Autoscaler ConfigMap %s/%s not found in cluster (%s), install the cluster-autoscaler add-on first", clusterAutoscalerNamespace, clusterAutoscalerConfigMap, cluster)
+		}
+		return fmt.Errorf("[ERROR] Error retrieving the autoscaler ConfigMap of cluster (%s): %s", cluster, err)
+	}
+
+	if configMap.Data == nil {
+		configMap.Data = map[string]string{}
+	}
+	configMap.Data[clusterAutoscalerWorkerPoolKey] = string(poolsConfig)
+	for arg, key := range clusterAutoscalerParameters {
+		if v, ok := d.GetOk(arg); ok {
+			configMap.Data[key] = v.(string)
+		}
+	}
+	for arg, key := range clusterAutoscalerBoolParameters {
+		if v, ok := d.GetOkExists(arg); ok {
+			configMap.Data[key] = strconv.FormatBool(v.(bool))
+		}
+	}
+
+	if _, err := configMaps.Update(context.TODO(), configMap, metav1.UpdateOptions{}); err != nil {
+		return fmt.Errorf("[ERROR] Error updating the autoscaler ConfigMap of cluster (%s): %s", cluster, err)
+	}
+	return nil
+}
+
+func expandClusterAutoscalerWorkerPools(d *schema.ResourceData) []clusterAutoscalerWorkerPool {
+	workerPools := []clusterAutoscalerWorkerPool{}
+	for _, p := range d.Get("worker_pool").([]interface{}) {
+		pool := p.(map[string]interface{})
+		workerPools = append(workerPools, clusterAutoscalerWorkerPool{
+			Name:    pool["name"].(string),
+			MinSize: pool["min_size"].(int),
+			MaxSize: pool["max_size"].(int),
+			Enabled: pool["enabled"].(bool),
+		})
+	}
+	return workerPools
+}
+
+// getClusterKubeClientset returns a Kubernetes client for the cluster API endpoint that authenticates with the
+// admin credentials of the cluster.
+func getClusterKubeClientset(d *schema.ResourceData, meta interface{}, cluster string) (*kubernetes.Clientset, error) {
+	csClient, err := meta.(conns.ClientSession).VpcContainerAPI()
+	if err != nil {
+		return nil, err
+	}
+	targetEnv, err := getVpcClusterTargetHeader(d, meta)
+	if err != nil {
+		return nil, err
+	}
+	endpointType := ""
+	if v, ok := d.GetOk("endpoint_type"); ok {
+		endpointType = v.(string)
+	}
+
+	config, err := getClusterRestConfig(csClient, cluster, endpointType, targetEnv)
+	if err != nil {
+		return nil, err
+	}
+	clientset, err := kubernetes.NewForConfig(config)
+	if err != nil {
+		return nil, fmt.Errorf("[ERROR] Error creating the Kubernetes client for cluster (%s): %s", cluster, err)
+	}
+	return clientset, nil
+}
+
+func getClusterRestConfig(csClient v2.ContainerServiceAPI, cluster, endpointType string, target v2.ClusterTargetHeader) (*rest.Config, error) {
+	credentials, err := getClusterCredentials(csClient, cluster, true, endpointType, target)
+	if err != nil {
+		return nil, fmt.Errorf("[ERROR] Error fetching the credentials of cluster [%s]: %s", cluster, err)
+	}
+	config := &rest.Config{
+		Host: credentials.Host,
+		TLSClientConfig: rest.TLSClientConfig{
+			CAData: []byte(credentials.CACertificate),
+		},
+	}
+	if credentials.Token != "" {
+		config.BearerToken = credentials.Token
+	} else {
+		config.TLSClientConfig.CertData = []byte(credentials.ClientCertificate)
+		config.TLSClientConfig.KeyData = []byte(credentials.ClientKey)
+	}
+	return config, nil
+}
@@ -0,0 +1,59 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package kubernetes_test
+
+import (
+	"fmt"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIBMContainerClusterAutoscaler_Basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMContainerClusterAutoscalerBasic(acc.ClusterName, 1, 3),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"ibm_container_cluster_autoscaler.autoscaler", "worker_pool.#", "1"),
+					resource.TestCheckResourceAttr(
+						"ibm_container_cluster_autoscaler.autoscaler", "worker_pool.0.min_size", "1"),
+					resource.TestCheckResourceAttr(
+						"ibm_container_cluster_autoscaler.autoscaler", "worker_pool.0.max_size", "3"),
+					resource.TestCheckResourceAttr(
+						"ibm_container_cluster_autoscaler.autoscaler", "scan_interval", "2m"),
+				),
+			},
+			{
+				Config: testAccCheckIBMContainerClusterAutoscalerBasic(acc.ClusterName, 2, 4),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"ibm_container_cluster_autoscaler.autoscaler", "worker_pool.0.min_size", "2"),
+					resource.TestCheckResourceAttr(
+						"ibm_container_cluster_autoscaler.autoscaler", "worker_pool.0.max_size", "4"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMContainerClusterAutoscalerBasic(cluster string, minSize, maxSize int) string {
+	return fmt.Sprintf(`
+	resource "ibm_container_cluster_autoscaler" "autoscaler" {
+		cluster = "%s"
+		worker_pool {
+			name     = "default"
+			min_size = %d
+			max_size = %d
+		}
+		scan_interval            = "2m"
+		scale_down_unneeded_time = "10m"
+	}
+	`, cluster, minSize, maxSize)
+}
@@ -0,0 +1,448 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package kubernetes
+
+import (
+	"fmt"
+	gohttp "net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	v2 "github.com/IBM-Cloud/bluemix-go/api/container/containerv2"
+	"github.com/IBM-Cloud/bluemix-go/bmxerror"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const (
+	ingressStatusPending = "pending"
+	ingressStatusReady   = "ready"
+	ingressStatusDeleted = "deleted"
+)
+
+func ResourceIBMContainerIngressDomain() *schema.Resource {
+	return &schema.Resource{
+		Create:   resourceIBMContainerIngressDomainCreate,
+		Read:     resourceIBMContainerIngressDomainRead,
+		Update:   resourceIBMContainerIngressDomainUpdate,
+		Delete:   resourceIBMContainerIngressDomainDelete,
+		Exists:   resourceIBMContainerIngressDomainExists,
+		Importer: &schema.ResourceImporter{},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"cluster": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Cluster ID or name",
+				ValidateFunc: validate.InvokeValidator(
+					"ibm_container_ingress_domain",
+					"cluster"),
+			},
+			"domain": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: "The domain name. If not set, a domain is generated by IBM Cloud",
+			},
+			"dns_provider": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: "The DNS provider of the domain, akamai for IBM managed domains, akamai-ext or cis-ext for user managed domains",
+				ValidateFunc: validate.InvokeValidator(
+					"ibm_container_ingress_domain",
+					"dns_provider"),
+			},
+			"dns_provider_crn": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "The CRN of the DNS provider instance, required for cis-ext domains",
+			},
+			"domain_zone": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: "The zone of the DNS provider that the domain belongs to",
+			},
+			"is_default": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "If set to true the domain is used as the default domain of the cluster",
+			},
+			"ip_addresses": {
+				Type:          schema.TypeSet,
+				Optional:      true,
+				Computed:      true,
+				Elem:          &schema.Schema{Type: schema.TypeString},
+				Set:           schema.HashString,
+				ConflictsWith: []string{"lb_hostname"},
+				Description:   "The IP addresses the domain is registered with",
+			},
+			"lb_hostname": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ConflictsWith: []string{"ip_addresses"},
+				Description:   "The load balancer hostname the domain is registered with",
+			},
+			"secret_namespace": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "The namespace of the TLS secret that is bound to the domain",
+			},
+			"secret_name": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The name of the TLS secret that is bound to the domain",
+			},
+			"secret_status": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The status of the TLS secret that is bound to the domain",
+			},
+			"status": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The status of the domain",
+			},
+		},
+	}
+}
+
+func ResourceIBMContainerIngressDomainValidator() *validate.ResourceValidator {
+	validateSchema := make([]validate.ValidateSchema, 0)
+	validateSchema = append(validateSchema,
+		validate.ValidateSchema{
+			Identifier:                 "cluster",
+			ValidateFunctionIdentifier: validate.ValidateCloudData,
+			Type:                       validate.TypeString,
+			Required:                   true,
+			CloudDataType:              "cluster",
+			CloudDataRange:             []string{"resolved_to:id"}},
+		validate.ValidateSchema{
+			Identifier:                 "dns_provider",
+			ValidateFunctionIdentifier: validate.ValidateAllowedStringValue,
+			Type:                       validate.TypeString,
+			Optional:                   true,
+			AllowedValues:              "akamai,akamai-ext,cis-ext"})
+
+	iBMContainerIngressDomainValidator := validate.ResourceValidator{ResourceName: "ibm_container_ingress_domain", Schema: validateSchema}
+	return &iBMContainerIngressDomainValidator
+}
+
+// ingressDomain is the ingress domain as returned by the ingress domain API. The API is not part of the
+// bluemix-go version in use, so the requests are sent with the raw container client.
+type ingressDomain struct {
+	Cluster         string   `json:"cluster,omitempty"`
+	Domain          string   `json:"domain,omitempty"`
+	DNSProvider     string   `json:"dnsProvider,omitempty"`
+	DNSProviderCRN  string   `json:"dnsProviderCRN,omitempty"`
+	DomainZone      string   `json:"domainZone,omitempty"`
+	IsDefault       bool     `json:"isDefault"`
+	IPAddresses     []string `json:"ipAddresses,omitempty"`
+	LBHostname      string   `json:"lbHostname,omitempty"`
+	SecretNamespace string   `json:"secretNamespace,omitempty"`
+	SecretName      string   `json:"secretName,omitempty"`
+	SecretStatus    string   `json:"secretStatus,omitempty"`
+	Status          string   `json:"status,omitempty"`
+}
+
+// containerAPIRawClient exposes the raw request methods of the container client, used for APIs that are not
+// part of the bluemix-go version in use.
+type containerAPIRawClient interface {
+	Get(path string, respV interface{}, extraHeader ...interface{}) (*gohttp.Response, error)
+	Post(path string, data interface{}, respV interface{}, extraHeader ...interface{}) (*gohttp.Response, error)
+}
+
+func getContainerAPIRawClient(meta interface{}) (containerAPIRawClient, error) {
+	csClient, err := meta.(conns.ClientSession).VpcContainerAPI()
+	if err != nil {
+		return nil, err
+	}
+	client, ok := csClient.(containerAPIRawClient)
+	if !ok {
+		return nil, fmt.Errorf("[ERROR] The container client does not support raw requests")
+	}
+	return client, nil
+}
+
+// ingressStatusState maps the status of an ingress domain or health check to the pending or ready state of a
+// wait, and fails for statuses that report an error.
+func ingressStatusState(status string) (string, error) {
+	switch strings.ToLower(status) {
+	case "", "pending", "creating", "updating", "in progress":
+		return ingressStatusPending, nil
+	case "failed", "error", "critical":
+		return "", fmt.Errorf("[ERROR] The status is %s", status)
+	case ingressStatusDeleted:
+		return ingressStatusDeleted, nil
+	}
+	return ingressStatusReady, nil
+}
+
+func getIngressDomain(client containerAPIRawClient, cluster, domain string, target v2.ClusterTargetHeader) (ingressDomain, error) {
+	ingressDomain := ingressDomain{}
+	rawURL := fmt.Sprintf("/ingress/v2/domain/getDomain?cluster=%s&domain=%s", url.QueryEscape(cluster), url.QueryEscape(domain))
+	_, err := client.Get(rawURL, &ingressDomain, target.ToMap())
+	return ingressDomain, err
+}
+
+func resourceIBMContainerIngressDomainCreate(d *schema.ResourceData, meta interface{}) error {
+	client, err := getContainerAPIRawClient(meta)
+	if err != nil {
+		return err
+	}
+	targetEnv, err := getVpcClusterTargetHeader(d, meta)
+	if err != nil {
+		return err
+	}
+
+	cluster := d.Get("cluster").(string)
+	params := ingressDomain{
+		Cluster:         cluster,
+		Domain:          d.Get("domain").(string),
+		DNSProvider:     d.Get("dns_provider").(string),
+		DNSProviderCRN:  d.Get("dns_provider_crn").(string),
+		DomainZone:      d.Get("domain_zone").(string),
+		IsDefault:       d.Get("is_default").(bool),
+		LBHostname:      d.Get("lb_hostname").(string),
+		SecretNamespace: d.Get("secret_namespace").(string),
+	}
+	if v, ok := d.GetOk("ip_addresses"); ok {
+		params.IPAddresses = flex.ExpandStringList(v.(*schema.Set).List())
+	}
+
+	if params.DNSProvider == "cis-ext" && params.DNSProviderCRN == "" {
+		return fmt.Errorf("[ERROR] dns_provider_crn is required for cis-ext ingress domains")
+	}
+
+	response := ingressDomain{}
+	_, err = client.Post("/ingress/v2/domain/createDomain", params, &response, targetEnv.ToMap())
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error creating ingress domain for cluster %s: %s", cluster, err)
+	}
+	domain := response.Domain
+	if domain == "" {
+		domain = params.Domain
+	}
+	if domain == "" {
+		return fmt.Errorf("[ERROR] Error creating ingress domain for cluster %s: the response does not contain the domain name", cluster)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", cluster, domain))
+
+	_, err = waitForIngressDomainReady(client, cluster, domain, targetEnv, d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error waiting for ingress domain %s to be ready: %s", domain, err)
+	}
+
+	return resourceIBMContainerIngressDomainRead(d, meta)
+}
+
+func resourceIBMContainerIngressDomainRead(d *schema.ResourceData, meta interface{}) error {
+	client, err := getContainerAPIRawClient(meta)
+	if err != nil {
+		return err
+	}
+	targetEnv, err := getVpcClusterTargetHeader(d, meta)
+	if err != nil {
+		return err
+	}
+
+	parts, err := flex.IdParts(d.Id())
+	if err != nil {
+		return err
+	}
+	cluster := parts[0]
+	domain := parts[1]
+
+	ingressDomain, err := getIngressDomain(client, cluster, domain, targetEnv)
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error getting ingress domain %s: %s", domain, err)
+	}
+
+	d.Set("cluster", cluster)
+	d.Set("domain", ingressDomain.Domain)
+	d.Set("dns_provider", ingressDomain.DNSProvider)
+	if ingressDomain.DNSProviderCRN != "" {
+		d.Set("dns_provider_crn", ingressDomain.DNSProviderCRN)
+	}
+	d.Set("domain_zone", ingressDomain.DomainZone)
+	d.Set("is_default", ingressDomain.IsDefault)
+	d.Set("ip_addresses", ingressDomain.IPAddresses)
+	d.Set("lb_hostname", ingressDomain.LBHostname)
+	d.Set("secret_namespace", ingressDomain.SecretNamespace)
+	d.Set("secret_name", ingressDomain.SecretName)
+	d.Set("secret_status", ingressDomain.SecretStatus)
+	d.Set("status", ingressDomain.Status)
+
+	return nil
+}
+
+func resourceIBMContainerIngressDomainUpdate(d *schema.ResourceData, meta interface{}) error {
+	client, err := getContainerAPIRawClient(meta)
+	if err != nil {
+		return err
+	}
+	targetEnv, err := getVpcClusterTargetHeader(d, meta)
+	if err != nil {
+		return err
+	}
+
+	parts, err := flex.IdParts(d.Id())
+	if err != nil {
+		return err
+	}
+	cluster := parts[0]
+	domain := parts[1]
+
+	if d.HasChanges("is_default", "ip_addresses", "lb_hostname", "secret_namespace") {
+		params := ingressDomain{
+			Cluster:         cluster,
+			Domain:          domain,
+			IsDefault:       d.Get("is_default").(bool),
+			LBHostname:      d.Get("lb_hostname").(string),
+			SecretNamespace: d.Get("secret_namespace").(string),
+		}
+		if v, ok := d.GetOk("ip_addresses"); ok {
+			params.IPAddresses = flex.ExpandStringList(v.(*schema.Set).List())
+		}
+		_, err = client.Post("/ingress/v2/domain/updateDomain", params, nil, targetEnv.ToMap())
+		if err != nil {
+			return fmt.Errorf("[ERROR] Error updating ingress domain %s: %s", domain, err)
+		}
+		_, err = waitForIngressDomainReady(client, cluster, domain, targetEnv, d.Timeout(schema.TimeoutUpdate))
+		if err != nil {
+			return fmt.Errorf("[ERROR] Error waiting for ingress domain %s to be updated: %s", domain, err)
+		}
+	}
+
+	return resourceIBMContainerIngressDomainRead(d, meta)
+}
+
+func resourceIBMContainerIngressDomainDelete(d *schema.ResourceData, meta interface{}) error {
+	client, err := getContainerAPIRawClient(meta)
+	if err != nil {
+		return err
+	}
+	targetEnv, err := getVpcClusterTargetHeader(d, meta)
+	if err != nil {
+		return err
+	}
+
+	parts, err := flex.IdParts(d.Id())
+	if err != nil {
+		return err
+	}
+	cluster := parts[0]
+	domain := parts[1]
+
+	params := ingressDomain{
+		Cluster: cluster,
+		Domain:  domain,
+	}
+	_, err = client.Post("/ingress/v2/domain/deleteDomain", params, nil, targetEnv.ToMap())
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error deleting ingress domain %s: %s", domain, err)
+	}
+	_, err = waitForIngressDomainDeleted(client, cluster, domain, targetEnv, d.Timeout(schema.TimeoutDelete))
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error waiting for ingress domain %s to be deleted: %s", domain, err)
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func waitForIngressDomainReady(client containerAPIRawClient, cluster, domain string, target v2.ClusterTargetHeader, timeout time.Duration) (interface{}, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{ingressStatusPending},
+		Target:  []string{ingressStatusReady},
+		Refresh: func() (interface{}, string, error) {
+			ingressDomain, err := getIngressDomain(client, cluster, domain, target)
+			if err != nil {
+				return nil, "", err
+			}
+			state, err := ingressStatusState(ingressDomain.Status)
+			if err != nil {
+				return ingressDomain, "", err
+			}
+			return ingressDomain, state, nil
+		},
+		Timeout:    timeout,
+		Delay:      5 * time.Second,
+		MinTimeout: 5 * time.Second,
+	}
+	return stateConf.WaitForState()
+}
+
+func waitForIngressDomainDeleted(client containerAPIRawClient, cluster, domain string, target v2.ClusterTargetHeader, timeout time.Duration) (interface{}, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{ingressStatusPending},
+		Target:  []string{ingressStatusDeleted},
+		Refresh: func() (interface{}, string, error) {
+			ingressDomain, err := getIngressDomain(client, cluster, domain, target)
+			if err != nil {
+				if apiErr, ok := err.(bmxerror.RequestFailure); ok && apiErr.StatusCode() == 404 {
+					return ingressDomain, ingressStatusDeleted, nil
+				}
+				return nil, "", err
+			}
+			if strings.ToLower(ingressDomain.Status) == ingressStatusDeleted {
+				return ingressDomain, ingressStatusDeleted, nil
+			}
+			return ingressDomain, ingressStatusPending, nil
+		},
+		Timeout:    timeout,
+		Delay:      5 * time.Second,
+		MinTimeout: 5 * time.Second,
+	}
+	return stateConf.WaitForState()
+}
+
+func resourceIBMContainerIngressDomainExists(d *schema.ResourceData, meta interface{}) (bool, error) {
+	client, err := getContainerAPIRawClient(meta)
+	if err != nil {
+		return false, err
+	}
+	targetEnv, err := getVpcClusterTargetHeader(d, meta)
+	if err != nil {
+		return false, err
+	}
+
+	parts, err := flex.IdParts(d.Id())
+	if err != nil {
+		return false, err
+	}
+	cluster := parts[0]
+	domain := parts[1]
+
+	ingressDomain, err := getIngressDomain(client, cluster, domain, targetEnv)
+	if err != nil {
+		if apiErr, ok := err.(bmxerror.RequestFailure); ok {
+			if apiErr.StatusCode() == 404 {
+				return false, nil
+			}
+		}
+		return false, fmt.Errorf("[ERROR] Error getting ingress domain: %s", err)
+	}
+
+	return ingressDomain.Domain == domain && ingressDomain.Status != "deleted", nil
+}
@@ -0,0 +1,56 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package kubernetes_test
+
+import (
+	"fmt"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIBMContainerIngressDomain_Basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMContainerIngressDomainBasic(false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"ibm_container_ingress_domain.domain", "cluster", acc.ClusterName),
+					resource.TestCheckResourceAttr(
+						"ibm_container_ingress_domain.domain", "dns_provider", "akamai"),
+					resource.TestCheckResourceAttr(
+						"ibm_container_ingress_domain.domain", "is_default", "false"),
+					resource.TestCheckResourceAttrSet(
+						"ibm_container_ingress_domain.domain", "domain"),
+				),
+			},
+			{
+				Config: testAccCheckIBMContainerIngressDomainBasic(true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"ibm_container_ingress_domain.domain", "is_default", "true"),
+				),
+			},
+			{
+				ResourceName:            "ibm_container_ingress_domain.domain",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"dns_provider_crn"},
+			},
+		},
+	})
+}
+
+func testAccCheckIBMContainerIngressDomainBasic(isDefault bool) string {
+	return fmt.Sprintf(`
+	resource "ibm_container_ingress_domain" "domain" {
+		cluster      = "%s"
+		dns_provider = "akamai"
+		is_default   = %t
+	}`, acc.ClusterName, isDefault)
+}
@@ -0,0 +1,488 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package kubernetes
+
+import (
+	"fmt"
+	"net/url"
+	"time"
+
+	v2 "github.com/IBM-Cloud/bluemix-go/api/container/containerv2"
+	"github.com/IBM-Cloud/bluemix-go/bmxerror"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func ResourceIBMContainerIngressHealthCheck() *schema.Resource {
+	return &schema.Resource{
+		Create:   resourceIBMContainerIngressHealthCheckCreate,
+		Read:     resourceIBMContainerIngressHealthCheckRead,
+		Update:   resourceIBMContainerIngressHealthCheckUpdate,
+		Delete:   resourceIBMContainerIngressHealthCheckDelete,
+		Exists:   resourceIBMContainerIngressHealthCheckExists,
+		Importer: &schema.ResourceImporter{},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(2 * time.Minute),
+			Update: schema.DefaultTimeout(2 * time.Minute),
+			Delete: schema.DefaultTimeout(2 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"cluster": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Cluster ID or name",
+				ValidateFunc: validate.InvokeValidator(
+					"ibm_container_ingress_health_check",
+					"cluster"),
+			},
+			"domain": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The ingress domain that is monitored",
+			},
+			"enabled": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "If set to false the health check is disabled",
+			},
+			"type": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "HTTPS",
+				Description: "The protocol of the health check, HTTP or HTTPS",
+				ValidateFunc: validate.InvokeValidator(
+					"ibm_container_ingress_health_check",
+					"type"),
+			},
+			"method": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "GET",
+				Description: "The HTTP method of the health check, GET or HEAD",
+				ValidateFunc: validate.InvokeValidator(
+					"ibm_container_ingress_health_check",
+					"method"),
+			},
+			"path": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "/",
+				Description: "The path that is requested by the health check",
+			},
+			"port": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Computed:    true,
+				Description: "The port that is requested by the health check, defaults to the port of the protocol",
+				ValidateFunc: validate.InvokeValidator(
+					"ibm_container_ingress_health_check",
+					"port"),
+			},
+			"interval": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Default:     60,
+				Description: "The interval between health checks in seconds",
+				ValidateFunc: validate.InvokeValidator(
+					"ibm_container_ingress_health_check",
+					"interval"),
+			},
+			"timeout": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Default:     5,
+				Description: "The timeout of a health check in seconds",
+				ValidateFunc: validate.InvokeValidator(
+					"ibm_container_ingress_health_check",
+					"timeout"),
+			},
+			"retries": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Default:     2,
+				Description: "The number of retries before the domain is marked unhealthy",
+				ValidateFunc: validate.InvokeValidator(
+					"ibm_container_ingress_health_check",
+					"retries"),
+			},
+			"expected_codes": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "2xx",
+				Description: "The expected HTTP response code or code range, for example 200 or 2xx",
+			},
+			"expected_body": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "A case insensitive substring that is expected in the response body",
+			},
+			"headers": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The HTTP request headers of the health check",
+			},
+			"follow_redirects": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "If set to true redirects are followed",
+			},
+			"allow_insecure": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "If set to true the certificate of the domain is not validated",
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The description of the health check",
+			},
+			"status": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The status of the health check",
+			},
+		},
+	}
+}
+
+func ResourceIBMContainerIngressHealthCheckValidator() *validate.ResourceValidator {
+	validateSchema := make([]validate.ValidateSchema, 0)
+	validateSchema = append(validateSchema,
+		validate.ValidateSchema{
+			Identifier:                 "cluster",
+			ValidateFunctionIdentifier: validate.ValidateCloudData,
+			Type:                       validate.TypeString,
+			Required:                   true,
+			CloudDataType:              "cluster",
+			CloudDataRange:             []string{"resolved_to:id"}},
+		validate.ValidateSchema{
+			Identifier:                 "type",
+			ValidateFunctionIdentifier: validate.ValidateAllowedStringValue,
+			Type:                       validate.TypeString,
+			Optional:                   true,
+			AllowedValues:              "HTTP,HTTPS"},
+		validate.ValidateSchema{
+			Identifier:                 "method",
+			ValidateFunctionIdentifier: validate.ValidateAllowedStringValue,
+			Type:                       validate.TypeString,
+			Optional:                   true,
+			AllowedValues:              "GET,HEAD"},
+		validate.ValidateSchema{
+			Identifier:                 "port",
+			ValidateFunctionIdentifier: validate.IntBetween,
+			Type:                       validate.TypeInt,
+			Optional:                   true,
+			MinValue:                   "1",
+			MaxValue:                   "65535"},
+		validate.ValidateSchema{
+			Identifier:                 "interval",
+			ValidateFunctionIdentifier: validate.IntBetween,
+			Type:                       validate.TypeInt,
+			Optional:                   true,
+			MinValue:                   "5",
+			MaxValue:                   "3600"},
+		validate.ValidateSchema{
+			Identifier:                 "timeout",
+			ValidateFunctionIdentifier: validate.IntBetween,
+			Type:                       validate.TypeInt,
+			Optional:                   true,
+			MinValue:                   "1",
+			MaxValue:                   "10"},
+		validate.ValidateSchema{
+			Identifier:                 "retries",
+			ValidateFunctionIdentifier: validate.IntBetween,
+			Type:                       validate.TypeInt,
+			Optional:                   true,
+			MinValue:                   "0",
+			MaxValue:                   "5"})
+
+	iBMContainerIngressHealthCheckValidator := validate.ResourceValidator{ResourceName: "ibm_container_ingress_health_check", Schema: validateSchema}
+	return &iBMContainerIngressHealthCheckValidator
+}
+
+// ingressHealthCheck is the health check of an ingress domain as used by the ingress domain API.
+type ingressHealthCheck struct {
+	Cluster         string              `json:"cluster,omitempty"`
+	Domain          string              `json:"domain,omitempty"`
+	Enabled         bool                `json:"enabled"`
+	Type            string              `json:"type,omitempty"`
+	Method          string              `json:"method,omitempty"`
+	Path            string              `json:"path,omitempty"`
+	Port            int                 `json:"port,omitempty"`
+	Interval        int                 `json:"interval,omitempty"`
+	Timeout         int                 `json:"timeout,omitempty"`
+	Retries         int                 `json:"retries"`
+	ExpectedCodes   string              `json:"expectedCodes,omitempty"`
+	ExpectedBody    string              `json:"expectedBody,omitempty"`
+	Headers         map[string][]string `json:"headers,omitempty"`
+	FollowRedirects bool                `json:"followRedirects"`
+	AllowInsecure   bool                `json:"allowInsecure"`
+	Description     string              `json:"description,omitempty"`
+	Status          string              `json:"status,omitempty"`
+}
+
+func getIngressHealthCheck(client containerAPIRawClient, cluster, domain string, target v2.ClusterTargetHeader) (ingressHealthCheck, error) {
+	healthCheck := ingressHealthCheck{}
+	rawURL := fmt.Sprintf("/ingress/v2/domain/health/getHealthCheck?cluster=%s&domain=%s", url.QueryEscape(cluster), url.QueryEscape(domain))
+	_, err := client.Get(rawURL, &healthCheck, target.ToMap())
+	return healthCheck, err
+}
+
+func expandIngressHealthCheck(d *schema.ResourceData, cluster, domain string) ingressHealthCheck {
+	healthCheck := ingressHealthCheck{
+		Cluster:         cluster,
+		Domain:          domain,
+		Enabled:         d.Get("enabled").(bool),
+		Type:            d.Get("type").(string),
+		Method:          d.Get("method").(string),
+		Path:            d.Get("path").(string),
+		Port:            d.Get("port").(int),
+		Interval:        d.Get("interval").(int),
+		Timeout:         d.Get("timeout").(int),
+		Retries:         d.Get("retries").(int),
+		ExpectedCodes:   d.Get("expected_codes").(string),
+		ExpectedBody:    d.Get("expected_body").(string),
+		FollowRedirects: d.Get("follow_redirects").(bool),
+		AllowInsecure:   d.Get("allow_insecure").(bool),
+		Description:     d.Get("description").(string),
+	}
+	if v, ok := d.GetOk("headers"); ok {
+		healthCheck.Headers = make(map[string][]string)
+		for name, value := range v.(map[string]interface{}) {
+			healthCheck.Headers[name] = []string{value.(string)}
+		}
+	}
+	return healthCheck
+}
+
+func flattenIngressHealthCheckHeaders(headers map[string][]string) map[string]string {
+	flattened := make(map[string]string)
+	for name, values := range headers {
+		if len(values) > 0 {
+			flattened[name] = values[0]
+		}
+	}
+	return flattened
+}
+
+func resourceIBMContainerIngressHealthCheckCreate(d *schema.ResourceData, meta interface{}) error {
+	client, err := getContainerAPIRawClient(meta)
+	if err != nil {
+		return err
+	}
+	targetEnv, err := getVpcClusterTargetHeader(d, meta)
+	if err != nil {
+		return err
+	}
+
+	cluster := d.Get("cluster").(string)
+	domain := d.Get("domain").(string)
+	params := expandIngressHealthCheck(d, cluster, domain)
+	_, err = client.Post("/ingress/v2/domain/health/createHealthCheck", params, nil, targetEnv.ToMap())
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error creating health check for ingress domain %s: %s", domain, err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", cluster, domain))
+
+	_, err = waitForIngressHealthCheckReady(client, cluster, domain, targetEnv, d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error waiting for health check of ingress domain %s to be ready: %s", domain, err)
+	}
+
+	return resourceIBMContainerIngressHealthCheckRead(d, meta)
+}
+
+func resourceIBMContainerIngressHealthCheckRead(d *schema.ResourceData, meta interface{}) error {
+	client, err := getContainerAPIRawClient(meta)
+	if err != nil {
+		return err
+	}
+	targetEnv, err := getVpcClusterTargetHeader(d, meta)
+	if err != nil {
+		return err
+	}
+
+	parts, err := flex.IdParts(d.Id())
+	if err != nil {
+		return err
+	}
+	cluster := parts[0]
+	domain := parts[1]
+
+	healthCheck, err := getIngressHealthCheck(client, cluster, domain, targetEnv)
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error getting health check for ingress domain %s: %s", domain, err)
+	}
+
+	d.Set("cluster", cluster)
+	d.Set("domain", domain)
+	d.Set("enabled", healthCheck.Enabled)
+	d.Set("type", healthCheck.Type)
+	d.Set("method", healthCheck.Method)
+	d.Set("path", healthCheck.Path)
+	d.Set("port", healthCheck.Port)
+	d.Set("interval", healthCheck.Interval)
+	d.Set("timeout", healthCheck.Timeout)
+	d.Set("retries", healthCheck.Retries)
+	d.Set("expected_codes", healthCheck.ExpectedCodes)
+	d.Set("expected_body", healthCheck.ExpectedBody)
+	d.Set("headers", flattenIngressHealthCheckHeaders(healthCheck.Headers))
+	d.Set("follow_redirects", healthCheck.FollowRedirects)
+	d.Set("allow_insecure", healthCheck.AllowInsecure)
+	d.Set("description", healthCheck.Description)
+	d.Set("status", healthCheck.Status)
+
+	return nil
+}
+
+func resourceIBMContainerIngressHealthCheckUpdate(d *schema.ResourceData, meta interface{}) error {
+	client, err := getContainerAPIRawClient(meta)
+	if err != nil {
+		return err
+	}
+	targetEnv, err := getVpcClusterTargetHeader(d, meta)
+	if err != nil {
+		return err
+	}
+
+	parts, err := flex.IdParts(d.Id())
+	if err != nil {
+		return err
+	}
+	cluster := parts[0]
+	domain := parts[1]
+
+	params := expandIngressHealthCheck(d, cluster, domain)
+	_, err = client.Post("/ingress/v2/domain/health/updateHealthCheck", params, nil, targetEnv.ToMap())
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error updating health check for ingress domain %s: %s", domain, err)
+	}
+	_, err = waitForIngressHealthCheckReady(client, cluster, domain, targetEnv, d.Timeout(schema.TimeoutUpdate))
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error waiting for health check of ingress domain %s to be updated: %s", domain, err)
+	}
+
+	return resourceIBMContainerIngressHealthCheckRead(d, meta)
+}
+
+func resourceIBMContainerIngressHealthCheckDelete(d *schema.ResourceData, meta interface{}) error {
+	client, err := getContainerAPIRawClient(meta)
+	if err != nil {
+		return err
+	}
+	targetEnv, err := getVpcClusterTargetHeader(d, meta)
+	if err != nil {
+		return err
+	}
+
+	parts, err := flex.IdParts(d.Id())
+	if err != nil {
+		return err
+	}
+	cluster := parts[0]
+	domain := parts[1]
+
+	params := ingressHealthCheck{
+		Cluster: cluster,
+		Domain:  domain,
+	}
+	_, err = client.Post("/ingress/v2/domain/health/deleteHealthCheck", params, nil, targetEnv.ToMap())
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error deleting health check for ingress domain %s: %s", domain, err)
+	}
+	_, err = waitForIngressHealthCheckDeleted(client, cluster, domain, targetEnv, d.Timeout(schema.TimeoutDelete))
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error waiting for health check of ingress domain %s to be deleted: %s", domain, err)
+	}
+
+	d.SetId("")
+	return nil
+}
+
+// waitForIngressHealthCheckReady waits until the health check can be read and its status is no longer pending.
+func waitForIngressHealthCheckReady(client containerAPIRawClient, cluster, domain string, target v2.ClusterTargetHeader, timeout time.Duration) (interface{}, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{ingressStatusPending},
+		Target:  []string{ingressStatusReady},
+		Refresh: func() (interface{}, string, error) {
+			healthCheck, err := getIngressHealthCheck(client, cluster, domain, target)
+			if err != nil {
+				if apiErr, ok := err.(bmxerror.RequestFailure); ok && apiErr.StatusCode() == 404 {
+					return healthCheck, ingressStatusPending, nil
+				}
+				return nil, "", err
+			}
+			state, err := ingressStatusState(healthCheck.Status)
+			if err != nil {
+				return healthCheck, "", err
+			}
+			return healthCheck, state, nil
+		},
+		Timeout:    timeout,
+		Delay:      5 * time.Second,
+		MinTimeout: 5 * time.Second,
+	}
+	return stateConf.WaitForState()
+}
+
+func waitForIngressHealthCheckDeleted(client containerAPIRawClient, cluster, domain string, target v2.ClusterTargetHeader, timeout time.Duration) (interface{}, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{ingressStatusPending},
+		Target:  []string{ingressStatusDeleted},
+		Refresh: func() (interface{}, string, error) {
+			healthCheck, err := getIngressHealthCheck(client, cluster, domain, target)
+			if err != nil {
+				if apiErr, ok := err.(bmxerror.RequestFailure); ok && apiErr.StatusCode() == 404 {
+					return healthCheck, ingressStatusDeleted, nil
+				}
+				return nil, "", err
+			}
+			return healthCheck, ingressStatusPending, nil
+		},
+		Timeout:    timeout,
+		Delay:      5 * time.Second,
+		MinTimeout: 5 * time.Second,
+	}
+	return stateConf.WaitForState()
+}
+
+func resourceIBMContainerIngressHealthCheckExists(d *schema.ResourceData, meta interface{}) (bool, error) {
+	client, err := getContainerAPIRawClient(meta)
+	if err != nil {
+		return false, err
+	}
+	targetEnv, err := getVpcClusterTargetHeader(d, meta)
+	if err != nil {
+		return false, err
+	}
+
+	parts, err := flex.IdParts(d.Id())
+	if err != nil {
+		return false, err
+	}
+	cluster := parts[0]
+	domain := parts[1]
+
+	_, err = getIngressHealthCheck(client, cluster, domain, targetEnv)
+	if err != nil {
+		if apiErr, ok := err.(bmxerror.RequestFailure); ok {
+			if apiErr.StatusCode() == 404 {
+				return false, nil
+			}
+		}
+		return false, fmt.Errorf("[ERROR] Error getting ingress health check: %s", err)
+	}
+
+	return true, nil
+}
@@ -0,0 +1,65 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package kubernetes_test
+
+import (
+	"fmt"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIBMContainerIngressHealthCheck_Basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMContainerIngressHealthCheckBasic("/healthz", 60),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"ibm_container_ingress_health_check.health_check", "type", "HTTPS"),
+					resource.TestCheckResourceAttr(
+						"ibm_container_ingress_health_check.health_check", "path", "/healthz"),
+					resource.TestCheckResourceAttr(
+						"ibm_container_ingress_health_check.health_check", "interval", "60"),
+					resource.TestCheckResourceAttr(
+						"ibm_container_ingress_health_check.health_check", "enabled", "true"),
+				),
+			},
+			{
+				Config: testAccCheckIBMContainerIngressHealthCheckBasic("/ready", 120),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"ibm_container_ingress_health_check.health_check", "path", "/ready"),
+					resource.TestCheckResourceAttr(
+						"ibm_container_ingress_health_check.health_check", "interval", "120"),
+				),
+			},
+			{
+				ResourceName:      "ibm_container_ingress_health_check.health_check",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckIBMContainerIngressHealthCheckBasic(path string, interval int) string {
+	return fmt.Sprintf(`
+	resource "ibm_container_ingress_domain" "domain" {
+		cluster      = "%s"
+		dns_provider = "akamai"
+	}
+
+	resource "ibm_container_ingress_health_check" "health_check" {
+		cluster        = ibm_container_ingress_domain.domain.cluster
+		domain         = ibm_container_ingress_domain.domain.domain
+		type           = "HTTPS"
+		path           = "%s"
+		interval       = %d
+		expected_codes = "2xx"
+	}`, acc.ClusterName, path, interval)
+}
@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"log"
 	"os"
+	"sort"
 	"strings"
 	"time"
 
@@ -48,6 +49,9 @@ func ResourceIBMContainerVpcCluster() *schema.Resource {
 			func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
 				return flex.ResourceTagsCustomizeDiff(diff)
 			},
+			func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
+				return workerPoolTaintsCustomizeDiff(diff)
+			},
 		),
 
 		Schema: map[string]*schema.Schema{
@@ -172,6 +176,8 @@ func ResourceIBMContainerVpcCluster() *schema.Resource {
 				Description: "Wait for worker node to update during kube version update.",
 			},
 
+			"update_strategy": vpcWorkerUpdateStrategySchema("ibm_container_vpc_cluster"),
+
 			"service_subnet": {
 				Type:        schema.TypeString,
 				Optional:    true,
@@ -487,6 +493,7 @@ func ResourceIBMContainerVpcClusterValidator() *validate.ResourceValidator {
 			Type:                       validate.TypeString,
 			Required:                   true,
 			AllowedValues:              tainteffects})
+	validateSchema = append(validateSchema, vpcWorkerUpdateStrategyValidators()...)
 
 	ibmContainerVpcClusteresourceValidator := validate.ResourceValidator{ResourceName: "ibm_container_vpc_cluster", Schema: validateSchema}
 	return &ibmContainerVpcClusteresourceValidator
@@ -774,7 +781,13 @@ func resourceIBMContainerVpcClusterUpdate(d *schema.ResourceData, meta interface
 		workersInfo := make(map[string]int)
 
 		updateAllWorkers := d.Get("update_all_workers").(bool)
-		if updateAllWorkers || d.HasChange("patch_version") || d.HasChange("retry_patch_version") {
+		if _, ok := d.GetOk("update_strategy"); ok && (updateAllWorkers || d.HasChange("patch_version") || d.HasChange("retry_patch_version")) {
+			err := updateVpcWorkersInBatches(d, meta, clusterID, "", expandVpcWorkerUpdateStrategy(d), targetEnv)
+			if err != nil {
+				d.Set("patch_version", nil)
+				return err
+			}
+		} else if updateAllWorkers || d.HasChange("patch_version") || d.HasChange("retry_patch_version") {
 
 			// patchVersion := d.Get("patch_version").(string)
 			workers, err := csClient.Workers().ListWorkers(clusterID, false, targetEnv)
@@ -1053,9 +1066,8 @@ func resourceIBMContainerVpcClusterRead(d *schema.ResourceData, meta interface{}
 	if cls.Vpcs != nil {
 		d.Set("vpc_id", cls.Vpcs[0])
 	}
-	if workerPool.Taints != nil {
-		d.Set("taints", flattenWorkerPoolTaints(workerPool))
-	}
+	// Always set the taints so that taints changed outside of terraform show up as drift
+	d.Set("taints", flattenWorkerPoolTaints(workerPool))
 	d.Set("master_url", cls.MasterURL)
 	d.Set("flavor", workerPool.Flavor)
 	d.Set("service_subnet", cls.ServiceSubnet)
@@ -1566,3 +1578,419 @@ func getNewWorkerID(d *schema.ResourceData, meta interface{}, targetEnv v2.Clust
 	}
 	return "", -1, fmt.Errorf("[ERROR] no new node found")
 }
+
+const (
+	workerUpdateHealthCheckReady   = "ready"
+	workerUpdateHealthCheckIngress = "ingress"
+	workerReplacing                = "replacing"
+	workerReplaced                 = "replaced"
+)
+
+// vpcWorkerUpdateStrategySchema returns the update_strategy block shared by ibm_container_vpc_cluster and
+// ibm_container_vpc_worker_pool.
+func vpcWorkerUpdateStrategySchema(resourceName string) *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Optional:    true,
+		MaxItems:    1,
+		Description: "Controls how outdated worker nodes are replaced in batches during patch and major version updates",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"max_unavailable": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					Default:      1,
+					ValidateFunc: validate.InvokeValidator(resourceName, "max_unavailable"),
+					Description:  "Maximum number of worker nodes of a worker pool that are replaced at the same time",
+				},
+				"max_surge": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					Default:      0,
+					ValidateFunc: validate.InvokeValidator(resourceName, "max_surge"),
+					Description:  "Number of extra worker nodes per zone added to a worker pool while it is updated. The extra capacity is added to the batch size and removed once the worker pool is updated",
+				},
+				"pool_order": {
+					Type:        schema.TypeList,
+					Optional:    true,
+					Elem:        &schema.Schema{Type: schema.TypeString},
+					Description: "Names of the worker pools in the order in which they are updated. Worker pools that are not listed are updated afterwards in alphabetical order",
+				},
+				"pause_between_batches": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					Default:      0,
+					ValidateFunc: validate.InvokeValidator(resourceName, "pause_between_batches"),
+					Description:  "Number of seconds to wait between two batches of worker node replacements",
+				},
+				"health_check": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					Default:      workerUpdateHealthCheckReady,
+					ValidateFunc: validate.InvokeValidator(resourceName, "health_check"),
+					Description:  "Health gate applied after each batch. `ready` waits for the new worker nodes to be normal, `ingress` additionally waits for the cluster ingress status to be healthy",
+				},
+			},
+		},
+	}
+}
+
+// vpcWorkerUpdateStrategyValidators returns the validators of the update_strategy block.
+func vpcWorkerUpdateStrategyValidators() []validate.ValidateSchema {
+	return []validate.ValidateSchema{
+		{
+			Identifier:                 "max_unavailable",
+			ValidateFunctionIdentifier: validate.IntAtLeast,
+			Type:                       validate.TypeInt,
+			Optional:                   true,
+			MinValue:                   "1"},
+		{
+			Identifier:                 "max_surge",
+			ValidateFunctionIdentifier: validate.IntAtLeast,
+			Type:                       validate.TypeInt,
+			Optional:                   true,
+			MinValue:                   "0"},
+		{
+			Identifier:                 "pause_between_batches",
+			ValidateFunctionIdentifier: validate.IntAtLeast,
+			Type:                       validate.TypeInt,
+			Optional:                   true,
+			MinValue:                   "0"},
+		{
+			Identifier:                 "health_check",
+			ValidateFunctionIdentifier: validate.ValidateAllowedStringValue,
+			Type:                       validate.TypeString,
+			Optional:                   true,
+			AllowedValues:              "ready,ingress"},
+	}
+}
+
+type vpcWorkerUpdateStrategy struct {
+	maxUnavailable      int
+	maxSurge            int
+	poolOrder           []string
+	pauseBetweenBatches time.Duration
+	healthCheck         string
+}
+
+func expandVpcWorkerUpdateStrategy(d *schema.ResourceData) vpcWorkerUpdateStrategy {
+	strategy := vpcWorkerUpdateStrategy{
+		maxUnavailable: 1,
+		healthCheck:    workerUpdateHealthCheckReady,
+	}
+	strategyList, ok := d.Get("update_strategy").([]interface{})
+	if !ok || len(strategyList) == 0 || strategyList[0] == nil {
+		return strategy
+	}
+	strategyMap := strategyList[0].(map[string]interface{})
+	if v, ok := strategyMap["max_unavailable"].(int); ok && v > 0 {
+		strategy.maxUnavailable = v
+	}
+	if v, ok := strategyMap["max_surge"].(int); ok {
+		strategy.maxSurge = v
+	}
+	if v, ok := strategyMap["pool_order"].([]interface{}); ok {
+		for _, pool := range v {
+			if pool != nil {
+				strategy.poolOrder = append(strategy.poolOrder, pool.(string))
+			}
+		}
+	}
+	if v, ok := strategyMap["pause_between_batches"].(int); ok {
+		strategy.pauseBetweenBatches = time.Duration(v) * time.Second
+	}
+	if v, ok := strategyMap["health_check"].(string); ok && v != "" {
+		strategy.healthCheck = v
+	}
+	return strategy
+}
+
+// orderVpcWorkerPools returns the pool names in the order requested by pool_order, followed by the remaining
+// pools in alphabetical order.
+func orderVpcWorkerPools(pools map[string][]v2.Worker, poolOrder []string) []string {
+	ordered := make([]string, 0, len(pools))
+	seen := make(map[string]bool)
+	for _, pool := range poolOrder {
+		if _, ok := pools[pool]; ok && !seen[pool] {
+			ordered = append(ordered, pool)
+			seen[pool] = true
+		}
+	}
+	remaining := make([]string, 0)
+	for pool := range pools {
+		if !seen[pool] {
+			remaining = append(remaining, pool)
+		}
+	}
+	sort.Strings(remaining)
+	return append(ordered, remaining...)
+}
+
+// updateVpcWorkersInBatches replaces the outdated worker nodes of a cluster, or of a single worker pool when
+// workerPool is set, following the update strategy.
+func updateVpcWorkersInBatches(d *schema.ResourceData, meta interface{}, clusterID, workerPool string, strategy vpcWorkerUpdateStrategy, target v2.ClusterTargetHeader) error {
+	csClient, err := meta.(conns.ClientSession).VpcContainerAPI()
+	if err != nil {
+		return err
+	}
+
+	var workers []v2.Worker
+	if workerPool != "" {
+		workers, err = csClient.Workers().ListByWorkerPool(clusterID, workerPool, false, target)
+	} else {
+		workers, err = csClient.Workers().ListWorkers(clusterID, false, target)
+	}
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error retrieving workers for cluster: %s", err)
+	}
+
+	outdated := make(map[string][]v2.Worker)
+	for _, worker := range workers {
+		// check if change is present in MAJOR.MINOR version or in PATCH version
+		if worker.KubeVersion.Actual != worker.KubeVersion.Target {
+			outdated[worker.PoolName] = append(outdated[worker.PoolName], worker)
+		}
+	}
+	return replaceVpcWorkersInBatches(d, meta, clusterID, outdated, strategy, target)
+}
+
+// replaceVpcWorkersInBatches replaces the given worker nodes, grouped by worker pool, following the update
+// strategy. It stops at the first batch whose workers do not pass the health check.
+func replaceVpcWorkersInBatches(d *schema.ResourceData, meta interface{}, clusterID string, workers map[string][]v2.Worker, strategy vpcWorkerUpdateStrategy, target v2.ClusterTargetHeader) error {
+	deadline := time.Now().Add(d.Timeout(schema.TimeoutUpdate))
+	batch := 0
+	for _, pool := range orderVpcWorkerPools(workers, strategy.poolOrder) {
+		poolWorkers := workers[pool]
+		surge, poolSize, err := surgeVpcWorkerPool(d, meta, clusterID, pool, strategy.maxSurge, target)
+		if err != nil {
+			return err
+		}
+		batchSize := strategy.maxUnavailable + surge
+		log.Printf("[INFO] Updating %d worker nodes of worker pool %s in batches of %d", len(poolWorkers), pool, batchSize)
+		for start := 0; start < len(poolWorkers); start += batchSize {
+			end := start + batchSize
+			if end > len(poolWorkers) {
+				end = len(poolWorkers)
+			}
+			if batch > 0 && strategy.pauseBetweenBatches > 0 {
+				log.Printf("[INFO] Pausing %s before the next batch of worker node replacements", strategy.pauseBetweenBatches)
+				if err = pauseVpcWorkerBatches(strategy.pauseBetweenBatches, deadline); err != nil {
+					break
+				}
+			}
+			batch++
+			if err = replaceVpcWorkerBatch(d, meta, clusterID, pool, poolWorkers[start:end], strategy, target); err != nil {
+				break
+			}
+		}
+		if surge > 0 {
+			// The surge worker nodes are removed also when a batch failed, so that the worker pool is not left oversized
+			if resizeErr := resizeVpcWorkerPool(d, meta, clusterID, pool, poolSize, target); resizeErr != nil {
+				if err != nil {
+					return fmt.Errorf("%s\n%s", err, resizeErr)
+				}
+				return resizeErr
+			}
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// pauseVpcWorkerBatches waits for pause between two batches of worker node replacements, or fails when the update
+// deadline passes first.
+func pauseVpcWorkerBatches(pause time.Duration, deadline time.Time) error {
+	paused := time.NewTimer(pause)
+	defer paused.Stop()
+	expired := time.NewTimer(time.Until(deadline))
+	defer expired.Stop()
+	select {
+	case <-paused.C:
+		return nil
+	case <-expired.C:
+		return fmt.Errorf("[ERROR] The update timeout expired while pausing %s between batches of worker node replacements", pause)
+	}
+}
+
+// surgeVpcWorkerPool adds maxSurge worker nodes per zone to the worker pool and returns the number of workers
+// added together with the original size per zone. Autoscaled worker pools are left untouched.
+func surgeVpcWorkerPool(d *schema.ResourceData, meta interface{}, clusterID, pool string, maxSurge int, target v2.ClusterTargetHeader) (int, int, error) {
+	if maxSurge == 0 {
+		return 0, 0, nil
+	}
+	csClient, err := meta.(conns.ClientSession).VpcContainerAPI()
+	if err != nil {
+		return 0, 0, err
+	}
+	workerPool, err := csClient.WorkerPools().GetWorkerPool(clusterID, pool, target)
+	if err != nil {
+		return 0, 0, fmt.Errorf("[ERROR] Error retrieving worker pool (%s) of cluster (%s): %s", pool, clusterID, err)
+	}
+	if workerPool.AutoscaleEnabled {
+		log.Printf("[WARN] Worker pool %s is autoscaled, max_surge is ignored", pool)
+		return 0, 0, nil
+	}
+	if err := resizeVpcWorkerPool(d, meta, clusterID, pool, workerPool.WorkerCount+maxSurge, target); err != nil {
+		return 0, 0, err
+	}
+	return maxSurge * len(workerPool.Zones), workerPool.WorkerCount, nil
+}
+
+// resizeVpcWorkerPool sets the number of worker nodes per zone of the worker pool and waits until all of its
+// workers are deployed.
+func resizeVpcWorkerPool(d *schema.ResourceData, meta interface{}, clusterID, pool string, size int, target v2.ClusterTargetHeader) error {
+	ClusterClient, err := meta.(conns.ClientSession).ContainerAPI()
+	if err != nil {
+		return err
+	}
+	Env := v1.ClusterTargetHeader{ResourceGroup: target.ResourceGroup}
+	err = ClusterClient.WorkerPools().ResizeWorkerPool(clusterID, pool, size, Env)
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error resizing worker pool (%s) of cluster (%s) to %d workers per zone: %s", pool, clusterID, size, err)
+	}
+	_, err = WaitForWorkerPoolAvailable(d, meta, clusterID, pool, d.Timeout(schema.TimeoutUpdate), target)
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error waiting for worker pool (%s) of cluster (%s) to be resized: %s", pool, clusterID, err)
+	}
+	return nil
+}
+
+// replaceVpcWorkerBatch replaces a batch of worker nodes of the same pool and waits for the replacements to pass
+// the health check.
+func replaceVpcWorkerBatch(d *schema.ResourceData, meta interface{}, clusterID, pool string, batch []v2.Worker, strategy vpcWorkerUpdateStrategy, target v2.ClusterTargetHeader) error {
+	csClient, err := meta.(conns.ClientSession).VpcContainerAPI()
+	if err != nil {
+		return err
+	}
+
+	existing, err := csClient.Workers().ListByWorkerPool(clusterID, pool, false, target)
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error retrieving workers of worker pool (%s): %s", pool, err)
+	}
+	knownWorkers := make(map[string]bool)
+	for _, worker := range existing {
+		knownWorkers[worker.ID] = true
+	}
+
+	replaced := make(map[string]bool)
+	for _, worker := range batch {
+		log.Printf("[INFO] Replacing worker node %s of worker pool %s", worker.ID, pool)
+		_, err := csClient.Workers().ReplaceWokerNode(clusterID, worker.ID, target)
+		// As API returns http response 204 NO CONTENT, error raised will be exempted.
+		if err != nil && !strings.Contains(err.Error(), "EmptyResponseBody") {
+			return fmt.Errorf("[ERROR] Error replacing the worker node %s from the cluster: %s", worker.ID, err)
+		}
+		replaced[worker.ID] = true
+	}
+
+	newWorkers, err := waitForVpcWorkersReplaced(d, csClient.Workers(), clusterID, pool, knownWorkers, replaced, target)
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error waiting for worker nodes of worker pool (%s) to be replaced: %s", pool, err)
+	}
+
+	_, err = waitForVpcWorkersHealthy(d, csClient.Workers(), clusterID, newWorkers, target)
+	if err != nil {
+		return fmt.Errorf("[ERROR] Aborting the update of cluster (%s), replaced worker nodes of worker pool (%s) are not healthy: %s", clusterID, pool, err)
+	}
+
+	if strategy.healthCheck == workerUpdateHealthCheckIngress {
+		_, err = waitForVpcClusterIngressHealthy(d, csClient.Albs(), clusterID, target)
+		if err != nil {
+			return fmt.Errorf("[ERROR] Aborting the update of cluster (%s), ingress is not healthy after replacing worker nodes of worker pool (%s): %s", clusterID, pool, err)
+		}
+	}
+	return nil
+}
+
+// waitForVpcWorkersReplaced waits until the replaced workers are gone and the same number of new workers has
+// joined the worker pool, and returns the IDs of the new workers.
+func waitForVpcWorkersReplaced(d *schema.ResourceData, client v2.Workers, clusterID, pool string, knownWorkers, replaced map[string]bool, target v2.ClusterTargetHeader) ([]string, error) {
+	var newWorkers []string
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{workerReplacing},
+		Target:  []string{workerReplaced},
+		Refresh: func() (interface{}, string, error) {
+			workers, err := client.ListByWorkerPool(clusterID, pool, false, target)
+			if err != nil {
+				return nil, "", fmt.Errorf("[ERROR] Error in retriving the list of worker nodes: %s", err)
+			}
+			newWorkers = newWorkers[:0]
+			for _, worker := range workers {
+				if replaced[worker.ID] && worker.LifeCycle.ActualState != workerDeleteState {
+					return workers, workerReplacing, nil
+				}
+				if !knownWorkers[worker.ID] {
+					newWorkers = append(newWorkers, worker.ID)
+				}
+			}
+			if len(newWorkers) < len(replaced) {
+				return workers, workerReplacing, nil
+			}
+			return workers, workerReplaced, nil
+		},
+		Timeout:      d.Timeout(schema.TimeoutUpdate),
+		Delay:        10 * time.Second,
+		MinTimeout:   5 * time.Second,
+		PollInterval: 5 * time.Second,
+	}
+	if _, err := stateConf.WaitForState(); err != nil {
+		return nil, err
+	}
+	return newWorkers, nil
+}
+
+// waitForVpcWorkersHealthy waits for the workers to be deployed and normal. A deployed worker reporting a
+// critical health state fails the wait immediately.
+func waitForVpcWorkersHealthy(d *schema.ResourceData, client v2.Workers, clusterID string, workerIDs []string, target v2.ClusterTargetHeader) (interface{}, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{"retry", versionUpdating},
+		Target:  []string{workerNormal},
+		Refresh: func() (interface{}, string, error) {
+			for _, workerID := range workerIDs {
+				worker, err := client.Get(clusterID, workerID, target)
+				if err != nil {
+					return nil, "retry", fmt.Errorf("[ERROR] Error retrieving worker of container vpc cluster: %s", err)
+				}
+				if worker.LifeCycle.ActualState == workerDesired && worker.Health.State == clusterCritical {
+					return worker, "", fmt.Errorf("[ERROR] Worker node %s is %s: %s", worker.ID, worker.Health.State, worker.Health.Message)
+				}
+				if worker.LifeCycle.ActualState != workerDesired || worker.Health.State != workerNormal {
+					log.Printf("worker: %s state: %s health: %s", worker.ID, worker.LifeCycle.ActualState, worker.Health.State)
+					return worker, versionUpdating, nil
+				}
+			}
+			return workerIDs, workerNormal, nil
+		},
+		Timeout:                   d.Timeout(schema.TimeoutUpdate),
+		Delay:                     10 * time.Second,
+		MinTimeout:                10 * time.Second,
+		ContinuousTargetOccurence: 3,
+	}
+	return stateConf.WaitForState()
+}
+
+// waitForVpcClusterIngressHealthy waits for the overall ingress status of the cluster to be healthy.
+func waitForVpcClusterIngressHealthy(d *schema.ResourceData, client v2.Alb, clusterID string, target v2.ClusterTargetHeader) (interface{}, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{"retry", deployInProgress},
+		Target:  []string{"healthy"},
+		Refresh: func() (interface{}, string, error) {
+			status, err := client.GetIngressStatus(clusterID, target)
+			if err != nil {
+				return nil, "retry", fmt.Errorf("[ERROR] Error retrieving ingress status of cluster (%s): %s", clusterID, err)
+			}
+			if status.Status != "healthy" {
+				log.Printf("ingress status of cluster %s: %s %s", clusterID, status.Status, status.Message)
+				return status, deployInProgress, nil
+			}
+			return status, status.Status, nil
+		},
+		Timeout:                   d.Timeout(schema.TimeoutUpdate),
+		Delay:                     10 * time.Second,
+		MinTimeout:                10 * time.Second,
+		ContinuousTargetOccurence: 3,
+	}
+	return stateConf.WaitForState()
+}
@@ -4,11 +4,14 @@
 package kubernetes
 
 import (
+	"context"
 	"fmt"
 	"log"
+	"net/url"
 	"strings"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
@@ -33,8 +36,17 @@ func ResourceIBMContainerVpcWorkerPool() *schema.Resource {
 		Delete:   resourceIBMContainerVpcWorkerPoolDelete,
 		Exists:   resourceIBMContainerVpcWorkerPoolExists,
 		Importer: &schema.ResourceImporter{},
+		CustomizeDiff: customdiff.Sequence(
+			func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
+				return workerPoolTaintsCustomizeDiff(diff)
+			},
+			func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
+				return workerPoolWorkerConfigCustomizeDiff(diff)
+			},
+		),
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(90 * time.Minute),
+			Update: schema.DefaultTimeout(90 * time.Minute),
 			Delete: schema.DefaultTimeout(90 * time.Minute),
 		},
 
@@ -221,6 +233,79 @@ func ResourceIBMContainerVpcWorkerPool() *schema.Resource {
 				Set:              flex.ResourceIBMVPCHash,
 				DiffSuppressFunc: flex.ApplyOnce,
 			},
+
+			"patch_version": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Kubernetes patch version. Changing it replaces the outdated worker nodes of the worker pool",
+			},
+
+			"retry_patch_version": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Description: "Argument which helps to retry the patch version updates on worker nodes. Increment the value to retry the patch updates if the previous apply fails",
+			},
+
+			"worker_config": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "Kubelet settings of the worker nodes. Changing them replaces the worker nodes of the worker pool following update_strategy",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"max_pods": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validate.InvokeValidator("ibm_container_vpc_worker_pool", "max_pods"),
+							Description:  "Maximum number of pods that can run on a worker node",
+						},
+						"pod_pids_limit": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validate.InvokeValidator("ibm_container_vpc_worker_pool", "pod_pids_limit"),
+							Description:  "Maximum number of process IDs per pod",
+						},
+						"cpu_manager_policy": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validate.InvokeValidator("ibm_container_vpc_worker_pool", "cpu_manager_policy"),
+							Description:  "CPU manager policy of the kubelet. Accepted values are none and static.",
+						},
+						"topology_manager_policy": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validate.InvokeValidator("ibm_container_vpc_worker_pool", "topology_manager_policy"),
+							Description:  "Topology manager policy of the kubelet. Accepted values are none, best-effort, restricted and single-numa-node.",
+						},
+						"image_gc_high_threshold_percent": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validate.InvokeValidator("ibm_container_vpc_worker_pool", "image_gc_high_threshold_percent"),
+							Description:  "Disk usage percentage after which image garbage collection always runs",
+						},
+						"image_gc_low_threshold_percent": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validate.InvokeValidator("ibm_container_vpc_worker_pool", "image_gc_low_threshold_percent"),
+							Description:  "Disk usage percentage before which image garbage collection never runs",
+						},
+						"container_log_max_size": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validate.InvokeValidator("ibm_container_vpc_worker_pool", "container_log_max_size"),
+							Description:  "Maximum size of a container log file before it is rotated, for example 10Mi",
+						},
+						"container_log_max_files": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validate.InvokeValidator("ibm_container_vpc_worker_pool", "container_log_max_files"),
+							Description:  "Maximum number of container log files kept per container",
+						},
+					},
+				},
+			},
+
+			"update_strategy": vpcWorkerUpdateStrategySchema("ibm_container_vpc_worker_pool"),
 		},
 	}
 }
@@ -251,6 +336,59 @@ func ResourceIBMContainerVPCWorkerPoolValidator() *validate.ResourceValidator {
 			Required:                   true,
 			CloudDataType:              "cluster",
 			CloudDataRange:             []string{"resolved_to:id"}})
+	validateSchema = append(validateSchema, vpcWorkerUpdateStrategyValidators()...)
+	validateSchema = append(validateSchema,
+		validate.ValidateSchema{
+			Identifier:                 "max_pods",
+			ValidateFunctionIdentifier: validate.IntBetween,
+			Type:                       validate.TypeInt,
+			Optional:                   true,
+			MinValue:                   "10",
+			MaxValue:                   "250"},
+		validate.ValidateSchema{
+			Identifier:                 "pod_pids_limit",
+			ValidateFunctionIdentifier: validate.IntAtLeast,
+			Type:                       validate.TypeInt,
+			Optional:                   true,
+			MinValue:                   "1024"},
+		validate.ValidateSchema{
+			Identifier:                 "cpu_manager_policy",
+			ValidateFunctionIdentifier: validate.ValidateAllowedStringValue,
+			Type:                       validate.TypeString,
+			Optional:                   true,
+			AllowedValues:              "none,static"},
+		validate.ValidateSchema{
+			Identifier:                 "topology_manager_policy",
+			ValidateFunctionIdentifier: validate.ValidateAllowedStringValue,
+			Type:                       validate.TypeString,
+			Optional:                   true,
+			AllowedValues:              "none,best-effort,restricted,single-numa-node"},
+		validate.ValidateSchema{
+			Identifier:                 "image_gc_high_threshold_percent",
+			ValidateFunctionIdentifier: validate.IntBetween,
+			Type:                       validate.TypeInt,
+			Optional:                   true,
+			MinValue:                   "1",
+			MaxValue:                   "100"},
+		validate.ValidateSchema{
+			Identifier:                 "image_gc_low_threshold_percent",
+			ValidateFunctionIdentifier: validate.IntBetween,
+			Type:                       validate.TypeInt,
+			Optional:                   true,
+			MinValue:                   "1",
+			MaxValue:                   "100"},
+		validate.ValidateSchema{
+			Identifier:                 "container_log_max_size",
+			ValidateFunctionIdentifier: validate.ValidateRegexp,
+			Type:                       validate.TypeString,
+			Optional:                   true,
+			Regexp:                     `^[1-9][0-9]*(Ki|Mi|Gi)$`},
+		validate.ValidateSchema{
+			Identifier:                 "container_log_max_files",
+			ValidateFunctionIdentifier: validate.IntAtLeast,
+			Type:                       validate.TypeInt,
+			Optional:                   true,
+			MinValue:                   "2"})
 
 	containerVPCWorkerPoolTaintsValidator := validate.ResourceValidator{ResourceName: "ibm_container_vpc_worker_pool", Schema: validateSchema}
 	return &containerVPCWorkerPoolTaintsValidator
@@ -359,6 +497,14 @@ func resourceIBMContainerVpcWorkerPoolCreate(d *schema.ResourceData, meta interf
 		}
 	}
 
+	// The workers are provisioned before the kubelet settings can be set on the worker pool, so they are
+	// replaced to pick them up
+	if workerConfig, ok := d.GetOk("worker_config"); ok {
+		if err := updateWorkerPoolKubeletConfig(d, meta, clusterNameorID, params.Name, workerConfig.([]interface{}), targetEnv); err != nil {
+			return err
+		}
+	}
+
 	return resourceIBMContainerVpcWorkerPoolRead(d, meta)
 }
 
@@ -403,6 +549,16 @@ func resourceIBMContainerVpcWorkerPoolUpdate(d *schema.ResourceData, meta interf
 		}
 	}
 
+	if d.HasChange("worker_config") && !d.IsNewResource() {
+		targetEnv, err := getVpcClusterTargetHeader(d, meta)
+		if err != nil {
+			return err
+		}
+		if err := updateWorkerPoolKubeletConfig(d, meta, clusterNameOrID, workerPoolName, d.Get("worker_config").([]interface{}), targetEnv); err != nil {
+			return err
+		}
+	}
+
 	if d.HasChange("worker_count") {
 		clusterNameOrID := d.Get("cluster").(string)
 		workerPoolName := d.Get("worker_pool_name").(string)
@@ -485,6 +641,18 @@ func resourceIBMContainerVpcWorkerPoolUpdate(d *schema.ResourceData, meta interf
 		}
 	}
 
+	if (d.HasChange("patch_version") || d.HasChange("retry_patch_version")) && !d.IsNewResource() {
+		targetEnv, err := getVpcClusterTargetHeader(d, meta)
+		if err != nil {
+			return err
+		}
+		err = updateVpcWorkersInBatches(d, meta, clusterNameOrID, workerPoolName, expandVpcWorkerUpdateStrategy(d), targetEnv)
+		if err != nil {
+			d.Set("patch_version", nil)
+			return err
+		}
+	}
+
 	return resourceIBMContainerVpcWorkerPoolRead(d, meta)
 }
 
@@ -530,13 +698,153 @@ func flattenWorkerPoolTaints(taints v2.GetWorkerPoolResponse) []map[string]inter
 	for k, v := range taints.Taints {
 		taint := make(map[string]interface{})
 		taint["key"] = k
-		ve := strings.Split(v, ":")
-		taint["value"] = ve[0]
-		taint["effect"] = ve[1]
+		// taints are returned as "value:effect". The effect never contains a colon, so the value is everything
+		// before the last colon and keeps any colon or equals sign of its own
+		value, effect := v, ""
+		if i := strings.LastIndex(v, ":"); i >= 0 {
+			value, effect = v[:i], v[i+1:]
+		}
+		taint["value"] = value
+		taint["effect"] = effect
 		taintslist = append(taintslist, taint)
 	}
 	return taintslist
 }
+
+// workerPoolKubeletConfig holds the kubelet settings of a worker pool. The setWorkerPoolKubeletConfig API and
+// the kubeletConfig field of getWorkerPool are not part of the bluemix-go version in use.
+type workerPoolKubeletConfig struct {
+	MaxPods                     int    `json:"maxPods,omitempty"`
+	PodPidsLimit                int    `json:"podPidsLimit,omitempty"`
+	CPUManagerPolicy            string `json:"cpuManagerPolicy,omitempty"`
+	TopologyManagerPolicy       string `json:"topologyManagerPolicy,omitempty"`
+	ImageGCHighThresholdPercent int    `json:"imageGCHighThresholdPercent,omitempty"`
+	ImageGCLowThresholdPercent  int    `json:"imageGCLowThresholdPercent,omitempty"`
+	ContainerLogMaxSize         string `json:"containerLogMaxSize,omitempty"`
+	ContainerLogMaxFiles        int    `json:"containerLogMaxFiles,omitempty"`
+}
+
+type workerPoolKubeletConfigRequest struct {
+	Cluster       string                  `json:"cluster"`
+	WorkerPool    string                  `json:"workerpool"`
+	KubeletConfig workerPoolKubeletConfig `json:"kubeletConfig"`
+}
+
+type workerPoolKubeletConfigResponse struct {
+	KubeletConfig *workerPoolKubeletConfig `json:"kubeletConfig,omitempty"`
+}
+
+func expandWorkerPoolKubeletConfig(workerConfig []interface{}) workerPoolKubeletConfig {
+	config := workerPoolKubeletConfig{}
+	if len(workerConfig) == 0 || workerConfig[0] == nil {
+		return config
+	}
+	r := workerConfig[0].(map[string]interface{})
+	config.MaxPods = r["max_pods"].(int)
+	config.PodPidsLimit = r["pod_pids_limit"].(int)
+	config.CPUManagerPolicy = r["cpu_manager_policy"].(string)
+	config.TopologyManagerPolicy = r["topology_manager_policy"].(string)
+	config.ImageGCHighThresholdPercent = r["image_gc_high_threshold_percent"].(int)
+	config.ImageGCLowThresholdPercent = r["image_gc_low_threshold_percent"].(int)
+	config.ContainerLogMaxSize = r["container_log_max_size"].(string)
+	config.ContainerLogMaxFiles = r["container_log_max_files"].(int)
+	return config
+}
+
+func flattenWorkerPoolKubeletConfig(config *workerPoolKubeletConfig) []map[string]interface{} {
+	workerConfig := make([]map[string]interface{}, 0)
+	if config == nil || *config == (workerPoolKubeletConfig{}) {
+		return workerConfig
+	}
+	workerConfig = append(workerConfig, map[string]interface{}{
+		"max_pods":                        config.MaxPods,
+		"pod_pids_limit":                  config.PodPidsLimit,
+		"cpu_manager_policy":              config.CPUManagerPolicy,
+		"topology_manager_policy":         config.TopologyManagerPolicy,
+		"image_gc_high_threshold_percent": config.ImageGCHighThresholdPercent,
+		"image_gc_low_threshold_percent":  config.ImageGCLowThresholdPercent,
+		"container_log_max_size":          config.ContainerLogMaxSize,
+		"container_log_max_files":         config.ContainerLogMaxFiles,
+	})
+	return workerConfig
+}
+
+func getWorkerPoolKubeletConfig(client containerAPIRawClient, clusterNameOrID, workerPool string, target v2.ClusterTargetHeader) (*workerPoolKubeletConfig, error) {
+	response := workerPoolKubeletConfigResponse{}
+	rawURL := fmt.Sprintf("/v2/vpc/getWorkerPool?cluster=%s&workerpool=%s", url.QueryEscape(clusterNameOrID), url.QueryEscape(workerPool))
+	_, err := client.Get(rawURL, &response, target.ToMap())
+	return response.KubeletConfig, err
+}
+
+// updateWorkerPoolKubeletConfig sets the kubelet settings of the worker pool and replaces its worker nodes in
+// batches, following update_strategy, so that the settings take effect.
+func updateWorkerPoolKubeletConfig(d *schema.ResourceData, meta interface{}, clusterNameOrID, workerPool string, workerConfig []interface{}, target v2.ClusterTargetHeader) error {
+	client, err := getContainerAPIRawClient(meta)
+	if err != nil {
+		return err
+	}
+	params := workerPoolKubeletConfigRequest{
+		Cluster:       clusterNameOrID,
+		WorkerPool:    workerPool,
+		KubeletConfig: expandWorkerPoolKubeletConfig(workerConfig),
+	}
+	_, err = client.Post("/v2/setWorkerPoolKubeletConfig", params, nil, target.ToMap())
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error updating the worker_config of worker pool (%s): %s", workerPool, err)
+	}
+
+	csClient, err := meta.(conns.ClientSession).VpcContainerAPI()
+	if err != nil {
+		return err
+	}
+	workers, err := csClient.Workers().ListByWorkerPool(clusterNameOrID, workerPool, false, target)
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error retrieving workers of worker pool (%s): %s", workerPool, err)
+	}
+	if len(workers) == 0 {
+		return nil
+	}
+	return replaceVpcWorkersInBatches(d, meta, clusterNameOrID, map[string][]v2.Worker{workerPool: workers}, expandVpcWorkerUpdateStrategy(d), target)
+}
+
+// workerPoolWorkerConfigCustomizeDiff rejects image garbage collection thresholds where the low threshold is not
+// below the high threshold, which the kubelet refuses to start with.
+func workerPoolWorkerConfigCustomizeDiff(diff *schema.ResourceDiff) error {
+	workerConfig, ok := diff.GetOk("worker_config")
+	if !ok {
+		return nil
+	}
+	config := expandWorkerPoolKubeletConfig(workerConfig.([]interface{}))
+	if config.ImageGCHighThresholdPercent > 0 && config.ImageGCLowThresholdPercent > 0 && config.ImageGCLowThresholdPercent >= config.ImageGCHighThresholdPercent {
+		return fmt.Errorf("[ERROR] image_gc_low_threshold_percent (%d) must be lower than image_gc_high_threshold_percent (%d)", config.ImageGCLowThresholdPercent, config.ImageGCHighThresholdPercent)
+	}
+	return nil
+}
+
+// workerPoolTaintsCustomizeDiff rejects taints that share a key. The worker pool API stores one taint per key,
+// so duplicates would be collapsed and show up as a permanent diff.
+func workerPoolTaintsCustomizeDiff(diff *schema.ResourceDiff) error {
+	taints, ok := diff.GetOk("taints")
+	if !ok {
+		return nil
+	}
+	keys := make(map[string]bool)
+	for _, t := range taints.(*schema.Set).List() {
+		taint, ok := t.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		key, _ := taint["key"].(string)
+		if key == "" {
+			continue
+		}
+		if keys[key] {
+			return fmt.Errorf("[ERROR] Taint key %s is used more than once, a worker pool supports one taint per key", key)
+		}
+		keys[key] = true
+	}
+	return nil
+}
 func resourceIBMContainerVpcWorkerPoolRead(d *schema.ResourceData, meta interface{}) error {
 	wpClient, err := meta.(conns.ClientSession).VpcContainerAPI()
 	if err != nil {
@@ -591,9 +899,8 @@ func resourceIBMContainerVpcWorkerPoolRead(d *schema.ResourceData, meta interfac
 		d.Set("secondary_storage", workerPool.SecondaryStorageOption.Name)
 	}
 	d.Set("host_pool_id", workerPool.HostPoolID)
-	if workerPool.Taints != nil {
-		d.Set("taints", flattenWorkerPoolTaints(workerPool))
-	}
+	// Always set the taints so that taints changed outside of terraform show up as drift
+	d.Set("taints", flattenWorkerPoolTaints(workerPool))
 	if workerPool.WorkerVolumeEncryption != nil {
 		d.Set("kms_instance_id", workerPool.WorkerVolumeEncryption.KmsInstanceID)
 		d.Set("crk", workerPool.WorkerVolumeEncryption.WorkerVolumeCRKID)
@@ -602,6 +909,15 @@ func resourceIBMContainerVpcWorkerPoolRead(d *schema.ResourceData, meta interfac
 		}
 	}
 	d.Set("autoscale_enabled", workerPool.AutoscaleEnabled)
+	rawClient, err := getContainerAPIRawClient(meta)
+	if err != nil {
+		return err
+	}
+	kubeletConfig, err := getWorkerPoolKubeletConfig(rawClient, cluster, workerPoolID, targetEnv)
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error retrieving the worker_config of worker pool (%s): %s", workerPoolID, err)
+	}
+	d.Set("worker_config", flattenWorkerPoolKubeletConfig(kubeletConfig))
 	controller, err := flex.GetBaseController(meta)
 	if err != nil {
 		return err
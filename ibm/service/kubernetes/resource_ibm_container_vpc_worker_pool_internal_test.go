@@ -0,0 +1,104 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package kubernetes
+
+import (
+	"testing"
+
+	v2 "github.com/IBM-Cloud/bluemix-go/api/container/containerv2"
+	"gotest.tools/assert"
+)
+
+func TestWorkerPoolTaints(t *testing.T) {
+	testcases := []struct {
+		key    string
+		value  string
+		effect string
+	}{
+		{
+			key:    "nvidia.com/gpu",
+			value:  "true",
+			effect: "NoSchedule",
+		},
+		{
+			key:    "dedicated",
+			value:  "team=ml",
+			effect: "NoExecute",
+		},
+		{
+			key:    "endpoint",
+			value:  "host:8080",
+			effect: "PreferNoSchedule",
+		},
+		{
+			key:    "selector",
+			value:  "a=b:c=d",
+			effect: "NoSchedule",
+		},
+		{
+			key:    "empty",
+			value:  "",
+			effect: "NoSchedule",
+		},
+	}
+
+	for _, tc := range testcases {
+		taint := map[string]interface{}{"key": tc.key, "value": tc.value, "effect": tc.effect}
+		request := expandWorkerPoolTaints("cluster", "pool", []interface{}{taint})
+		assert.Equal(t, request.Taints[tc.key], tc.value+":"+tc.effect)
+
+		taints := flattenWorkerPoolTaints(v2.GetWorkerPoolResponse{Taints: request.Taints})
+		assert.Equal(t, len(taints), 1)
+		assert.Equal(t, taints[0]["key"], tc.key)
+		assert.Equal(t, taints[0]["value"], tc.value)
+		assert.Equal(t, taints[0]["effect"], tc.effect)
+	}
+}
+
+func TestWorkerPoolKubeletConfig(t *testing.T) {
+	testcases := []struct {
+		workerConfig []interface{}
+		expected     workerPoolKubeletConfig
+	}{
+		{
+			workerConfig: []interface{}{},
+			expected:     workerPoolKubeletConfig{},
+		},
+		{
+			workerConfig: []interface{}{
+				map[string]interface{}{
+					"max_pods":                        110,
+					"pod_pids_limit":                  4096,
+					"cpu_manager_policy":              "static",
+					"topology_manager_policy":         "single-numa-node",
+					"image_gc_high_threshold_percent": 85,
+					"image_gc_low_threshold_percent":  80,
+					"container_log_max_size":          "10Mi",
+					"container_log_max_files":         5,
+				},
+			},
+			expected: workerPoolKubeletConfig{
+				MaxPods:                     110,
+				PodPidsLimit:                4096,
+				CPUManagerPolicy:            "static",
+				TopologyManagerPolicy:       "single-numa-node",
+				ImageGCHighThresholdPercent: 85,
+				ImageGCLowThresholdPercent:  80,
+				ContainerLogMaxSize:         "10Mi",
+				ContainerLogMaxFiles:        5,
+			},
+		},
+	}
+
+	for _, tc := range testcases {
+		config := expandWorkerPoolKubeletConfig(tc.workerConfig)
+		assert.DeepEqual(t, config, tc.expected)
+
+		flattened := flattenWorkerPoolKubeletConfig(&config)
+		assert.Equal(t, len(flattened), len(tc.workerConfig))
+		if len(tc.workerConfig) > 0 {
+			assert.DeepEqual(t, flattened[0], tc.workerConfig[0])
+		}
+	}
+}
@@ -5,6 +5,7 @@ package kubernetes_test
 
 import (
 	"fmt"
+	"regexp"
 	"strings"
 	"testing"
 
@@ -544,3 +545,116 @@ func testAccCheckIBMOpcContainerWorkerPoolBasic(name, openshiftFlavour, openShif
 	}
 		`, name, acc.IksClusterVpcID, acc.IksClusterResourceGroupID, acc.IksClusterSubnetID, openshiftFlavour, openShiftworkerCount, operatingSystem)
 }
+
+func TestAccIBMContainerVpcClusterWorkerPoolUpdateStrategy(t *testing.T) {
+
+	name := fmt.Sprintf("tf-vpc-worker-%d", acctest.RandIntRange(10, 100))
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckIBMVpcContainerWorkerPoolDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMVpcContainerWorkerPoolUpdateStrategy(name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"ibm_container_vpc_worker_pool.test_pool", "update_strategy.#", "1"),
+					resource.TestCheckResourceAttr(
+						"ibm_container_vpc_worker_pool.test_pool", "update_strategy.0.max_unavailable", "2"),
+					resource.TestCheckResourceAttr(
+						"ibm_container_vpc_worker_pool.test_pool", "update_strategy.0.max_surge", "1"),
+					resource.TestCheckResourceAttr(
+						"ibm_container_vpc_worker_pool.test_pool", "update_strategy.0.health_check", "ingress"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMVpcContainerWorkerPoolUpdateStrategy(name string) string {
+	return fmt.Sprintf(`
+	provider "ibm" {
+		region="us-south"
+	}
+	data "ibm_resource_group" "resource_group" {
+		is_default=true
+	}
+	data "ibm_is_vpc" "vpc" {
+	  name = "cluster-squad-dallas-test"
+	}
+
+	data "ibm_is_subnet" "subnet1" {
+	  name                     = "cluster-squad-dallas-test-01"
+	}
+
+	resource "ibm_container_vpc_cluster" "cluster" {
+	  name              = "%[1]s"
+	  vpc_id            = data.ibm_is_vpc.vpc.id
+	  flavor            = "cx2.2x4"
+	  worker_count      = 1
+	  resource_group_id = data.ibm_resource_group.resource_group.id
+	  wait_till         = "MasterNodeReady"
+	  zones {
+		subnet_id = data.ibm_is_subnet.subnet1.id
+		name      = "us-south-1"
+	  }
+	}
+	resource "ibm_container_vpc_worker_pool" "test_pool" {
+	  cluster           = ibm_container_vpc_cluster.cluster.id
+	  worker_pool_name  = "%[1]s"
+	  flavor            = "cx2.2x4"
+	  vpc_id            = data.ibm_is_vpc.vpc.id
+	  worker_count      = 2
+	  resource_group_id = data.ibm_resource_group.resource_group.id
+	  zones {
+		name      = "us-south-1"
+		subnet_id = data.ibm_is_subnet.subnet1.id
+	  }
+	  update_strategy {
+		max_unavailable       = 2
+		max_surge             = 1
+		pause_between_batches = 60
+		health_check          = "ingress"
+	  }
+	}
+		`, name)
+}
+
+func TestAccIBMContainerVpcClusterWorkerPoolDuplicateTaintKeys(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccCheckIBMVpcContainerWorkerPoolDuplicateTaintKeys(),
+				ExpectError: regexp.MustCompile("Taint key gpu is used more than once"),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMVpcContainerWorkerPoolDuplicateTaintKeys() string {
+	return fmt.Sprintf(`
+	resource "ibm_container_vpc_worker_pool" "test_pool" {
+	  cluster          = "%s"
+	  worker_pool_name = "gpu"
+	  flavor           = "gx2.8x64x1v100"
+	  vpc_id           = "%s"
+	  worker_count     = 1
+	  zones {
+		name      = "us-south-1"
+		subnet_id = "%s"
+	  }
+	  taints {
+		key    = "gpu"
+		value  = "true"
+		effect = "NoSchedule"
+	  }
+	  taints {
+		key    = "gpu"
+		value  = "true"
+		effect = "NoExecute"
+	  }
+	}
+		`, acc.ClusterName, acc.IksClusterVpcID, acc.IksClusterSubnetID)
+}
@@ -0,0 +1,302 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package satellite
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/IBM-Cloud/container-services-go-sdk/kubernetesserviceapiv1"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func DataSourceIBMSatelliteLocationHealth() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceIBMSatelliteLocationHealthRead,
+
+		Schema: map[string]*schema.Schema{
+			"location": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The name or ID of the Satellite location",
+			},
+			"fail_on_unbalanced_zones": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "If set to true the data source fails when the host counts of the location zones differ by more than max_zone_imbalance",
+			},
+			"max_zone_imbalance": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Default:     0,
+				Description: "The maximum allowed difference between the host counts of the location zones",
+				ValidateFunc: validate.InvokeDataSourceValidator(
+					"ibm_satellite_location_health",
+					"max_zone_imbalance"),
+			},
+			"state": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The state of the location",
+			},
+			"status": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The status of the location",
+			},
+			"message": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The troubleshooting message when the location is not able to deploy clusters",
+			},
+			"control_plane_ready": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether the health of the location control plane is normal",
+			},
+			"master_health": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The health of the location control plane",
+			},
+			"host_attached_count": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The total number of hosts that are attached to the location",
+			},
+			"host_available_count": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The number of hosts that can be assigned to a cluster",
+			},
+			"zones_balanced": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether the host counts of the location zones differ by at most max_zone_imbalance",
+			},
+			"zones": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The hosts per location zone",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"zone": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The name of the zone",
+						},
+						"host_count": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "The number of hosts in the zone",
+						},
+						"assigned_host_count": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "The number of hosts in the zone that are assigned to the control plane or a cluster",
+						},
+						"unhealthy_host_count": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "The number of unhealthy hosts in the zone",
+						},
+					},
+				},
+			},
+			"unhealthy_hosts": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The hosts of the location that are not healthy",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"host_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The ID of the host",
+						},
+						"host_name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The name of the host",
+						},
+						"zone": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The zone of the host",
+						},
+						"status": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The health status of the host",
+						},
+						"reason": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The message that describes the health status of the host",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func DataSourceIBMSatelliteLocationHealthValidator() *validate.ResourceValidator {
+	validateSchema := make([]validate.ValidateSchema, 0)
+	validateSchema = append(validateSchema,
+		validate.ValidateSchema{
+			Identifier:                 "max_zone_imbalance",
+			ValidateFunctionIdentifier: validate.IntAtLeast,
+			Type:                       validate.TypeInt,
+			Optional:                   true,
+			MinValue:                   "0"})
+
+	satelliteLocationHealthValidator := validate.ResourceValidator{ResourceName: "ibm_satellite_location_health", Schema: validateSchema}
+	return &satelliteLocationHealthValidator
+}
+
+// satelliteHostHealthy reports whether the health status of a host is normal, ready for assignment or still
+// being provisioned.
+func satelliteHostHealthy(status string) bool {
+	return status == rsHostNormalStatus || status == rsHostReadyStatus || status == rsHostProvisioningStatus
+}
+
+// satelliteHostZone returns the zone a host is assigned to, or the value of its zone label for unassigned hosts.
+func satelliteHostZone(host kubernetesserviceapiv1.MultishiftQueueNode) string {
+	if host.Assignment != nil && host.Assignment.Zone != nil && *host.Assignment.Zone != "" {
+		return *host.Assignment.Zone
+	}
+	return host.Labels["zone"]
+}
+
+func dataSourceIBMSatelliteLocationHealthRead(d *schema.ResourceData, meta interface{}) error {
+	location := d.Get("location").(string)
+
+	satClient, err := meta.(conns.ClientSession).SatelliteClientSession()
+	if err != nil {
+		return err
+	}
+
+	getSatLocOptions := &kubernetesserviceapiv1.GetSatelliteLocationOptions{
+		Controller: &location,
+	}
+
+	var instance *kubernetesserviceapiv1.MultishiftGetController
+	var response *core.DetailedResponse
+	err = resource.Retry(1*time.Minute, func() *resource.RetryError {
+		instance, response, err = satClient.GetSatelliteLocation(getSatLocOptions)
+		if err != nil || instance == nil {
+			if response != nil && response.StatusCode == 404 {
+				return resource.RetryableError(err)
+			}
+			return resource.NonRetryableError(err)
+		}
+		return nil
+	})
+
+	if conns.IsResourceTimeoutError(err) {
+		instance, response, err = satClient.GetSatelliteLocation(getSatLocOptions)
+	}
+	if err != nil || instance == nil {
+		return fmt.Errorf("[ERROR] Error retrieving IBM cloud satellite location %s : %s\n%s", location, err, response)
+	}
+
+	getSatHostOptions := &kubernetesserviceapiv1.GetSatelliteHostsOptions{
+		Controller: &location,
+	}
+	hostList, response, err := satClient.GetSatelliteHosts(getSatHostOptions)
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error retrieving location hosts %s : %s\n%s", location, err, response)
+	}
+
+	d.SetId(*instance.ID)
+	d.Set("state", core.StringNilMapper(instance.State))
+	d.Set("status", core.StringNilMapper(instance.Status))
+	if instance.Deployments != nil {
+		d.Set("message", core.StringNilMapper(instance.Deployments.Message))
+	}
+	masterHealth := ""
+	if instance.Lifecycle != nil {
+		masterHealth = core.StringNilMapper(instance.Lifecycle.MasterHealth)
+	}
+	d.Set("master_health", masterHealth)
+	d.Set("control_plane_ready", masterHealth == isLocationNormal)
+	if instance.Hosts != nil {
+		if instance.Hosts.Total != nil {
+			d.Set("host_attached_count", *instance.Hosts.Total)
+		}
+		if instance.Hosts.Available != nil {
+			d.Set("host_available_count", *instance.Hosts.Available)
+		}
+	}
+
+	zoneNames := instance.WorkerZones
+	if len(zoneNames) == 0 {
+		zoneNames = instance.LocationZones
+	}
+	hostCount := make(map[string]int)
+	assignedCount := make(map[string]int)
+	unhealthyCount := make(map[string]int)
+	unhealthyHosts := make([]map[string]interface{}, 0)
+	for _, h := range hostList {
+		zone := satelliteHostZone(h)
+		hostCount[zone]++
+		if h.State != nil && *h.State == rsHostAssignedStatus {
+			assignedCount[zone]++
+		}
+		status := rsHostUnknownStatus
+		reason := ""
+		if h.Health != nil {
+			status = core.StringNilMapper(h.Health.Status)
+			reason = core.StringNilMapper(h.Health.Message)
+		}
+		if !satelliteHostHealthy(status) {
+			unhealthyCount[zone]++
+			unhealthyHosts = append(unhealthyHosts, map[string]interface{}{
+				"host_id":   core.StringNilMapper(h.ID),
+				"host_name": core.StringNilMapper(h.Name),
+				"zone":      zone,
+				"status":    status,
+				"reason":    reason,
+			})
+		}
+	}
+	sort.SliceStable(unhealthyHosts, func(i, j int) bool {
+		return unhealthyHosts[i]["host_name"].(string) < unhealthyHosts[j]["host_name"].(string)
+	})
+
+	zones := make([]map[string]interface{}, 0, len(zoneNames))
+	minHosts, maxHosts := -1, 0
+	for _, zone := range zoneNames {
+		zones = append(zones, map[string]interface{}{
+			"zone":                 zone,
+			"host_count":           hostCount[zone],
+			"assigned_host_count":  assignedCount[zone],
+			"unhealthy_host_count": unhealthyCount[zone],
+		})
+		if minHosts == -1 || hostCount[zone] < minHosts {
+			minHosts = hostCount[zone]
+		}
+		if hostCount[zone] > maxHosts {
+			maxHosts = hostCount[zone]
+		}
+	}
+	balanced := maxHosts-minHosts <= d.Get("max_zone_imbalance").(int) || len(zoneNames) == 0
+
+	d.Set("zones", zones)
+	d.Set("unhealthy_hosts", unhealthyHosts)
+	d.Set("zones_balanced", balanced)
+
+	if !balanced && d.Get("fail_on_unbalanced_zones").(bool) {
+		return fmt.Errorf("[ERROR] The zones of satellite location %s are unbalanced: the host counts per zone range from %d to %d, the maximum allowed difference is %d", location, minHosts, maxHosts, d.Get("max_zone_imbalance").(int))
+	}
+
+	return nil
+}
@@ -0,0 +1,52 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package satellite_test
+
+import (
+	"fmt"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccSatelliteLocationHealthDataSourceBasic(t *testing.T) {
+	name := fmt.Sprintf("tf-satellitelocation-%d", acctest.RandIntRange(10, 100))
+	managed_from := "wdc04"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+
+			{
+				Config: testAccCheckSatelliteLocationHealthDataSource(name, managed_from),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.ibm_satellite_location_health.health", "zones.#", "3"),
+					resource.TestCheckResourceAttr("data.ibm_satellite_location_health.health", "zones_balanced", "true"),
+					resource.TestCheckResourceAttr("data.ibm_satellite_location_health.health", "unhealthy_hosts.#", "0"),
+					resource.TestCheckResourceAttrSet("data.ibm_satellite_location_health.health", "control_plane_ready"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckSatelliteLocationHealthDataSource(name, managed_from string) string {
+	return fmt.Sprintf(`
+
+	resource "ibm_satellite_location" "location" {
+		location      = "%s"
+		managed_from  = "%s"
+		zones         = ["us-east-1", "us-east-2", "us-east-3"]
+	}
+
+	data "ibm_satellite_location_health" "health" {
+		location                 = ibm_satellite_location.location.id
+		fail_on_unbalanced_zones = true
+	}
+`, name, managed_from)
+}
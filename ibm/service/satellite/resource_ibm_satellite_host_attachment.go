@@ -0,0 +1,453 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package satellite
+
+import (
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/IBM-Cloud/container-services-go-sdk/kubernetesserviceapiv1"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const (
+	rsHostAssignedStatus   = "assigned"
+	rsHostUnassignedStatus = "unassigned"
+	rsHostRemovedStatus    = "removed"
+	rsHostWaitingStatus    = "waiting"
+)
+
+func ResourceIBMSatelliteHostAttachment() *schema.Resource {
+	return &schema.Resource{
+		Create:   resourceIBMSatelliteHostAttachmentCreate,
+		Read:     resourceIBMSatelliteHostAttachmentRead,
+		Delete:   resourceIBMSatelliteHostAttachmentDelete,
+		Importer: &schema.ResourceImporter{},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(75 * time.Minute),
+			Delete: schema.DefaultTimeout(45 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			hostLocation: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ExactlyOneOf: []string{hostLocation, "host_script_id"},
+				Description:  "The name or ID of the Satellite location",
+			},
+			"host_script_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ExactlyOneOf: []string{hostLocation, "host_script_id"},
+				Description:  "The ID of the ibm_satellite_attach_host_script data source that generated the script run on the host. The host is expected to register with the location of the script",
+			},
+			"host_labels": {
+				Type:     schema.TypeSet,
+				Required: true,
+				ForceNew: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validateSatelliteHostLabel,
+				},
+				Set:         schema.HashString,
+				Description: "The labels that the host must have to be selected, in key:value format, such as the labels of the attach host script",
+			},
+			"host_name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: "The name of the host to select. If not set, any registered host that matches the host labels is selected",
+			},
+			hostCluster: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: "The name or ID of a Satellite cluster to assign the host to. If not set, the host is assigned to the location control plane",
+			},
+			hostWorkerPool: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: "The name or ID of the worker pool within the cluster to assign the host to",
+			},
+			hostZone: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: "The zone within the cluster to assign the host to",
+			},
+			hostID: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The ID of the host that is assigned",
+			},
+			hostState: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Health status of the host",
+			},
+		},
+	}
+}
+
+// validateSatelliteHostLabel accepts labels in the key:value format that flex.FlattenKeyValues expects.
+func validateSatelliteHostLabel(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+	parts := strings.Split(value, ":")
+	if len(parts) != 2 || parts[0] == "" {
+		errors = append(errors, fmt.Errorf("%q must be a label in key:value format, got %q", k, value))
+	}
+	return
+}
+
+func resourceIBMSatelliteHostAttachmentCreate(d *schema.ResourceData, meta interface{}) error {
+	location := d.Get(hostLocation).(string)
+	if v, ok := d.GetOk("host_script_id"); ok {
+		scriptLocation, err := getSatelliteHostScriptLocation(v.(string), meta)
+		if err != nil {
+			return err
+		}
+		location = scriptLocation
+	}
+
+	selector := flex.FlattenKeyValues(d.Get("host_labels").(*schema.Set).List())
+	hostName := d.Get("host_name").(string)
+
+	hostAssignOptions := &kubernetesserviceapiv1.CreateSatelliteAssignmentOptions{
+		Controller: flex.PtrToString(location),
+		Labels:     make(map[string]string),
+	}
+	if v, ok := d.GetOk(hostCluster); ok {
+		hostAssignOptions.Cluster = flex.PtrToString(v.(string))
+	} else {
+		hostAssignOptions.Cluster = flex.PtrToString(location)
+	}
+	if v, ok := d.GetOk(hostWorkerPool); ok {
+		hostAssignOptions.Workerpool = flex.PtrToString(v.(string))
+	}
+	if v, ok := d.GetOk(hostZone); ok {
+		hostAssignOptions.Zone = flex.PtrToString(v.(string))
+	}
+
+	host, err := waitForHostRegistrationAndAssign(location, hostName, selector, hostAssignOptions, d.Timeout(schema.TimeoutCreate), meta)
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error waiting for a host that matches the labels %v to register with location (%s): %s", selector, location, err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", location, *host.ID))
+
+	_, err = waitForAssignedHostNormal(location, *host.ID, d.Timeout(schema.TimeoutCreate), meta)
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error waiting for host (%s) to get normal state: %s", *host.Name, err)
+	}
+
+	return resourceIBMSatelliteHostAttachmentRead(d, meta)
+}
+
+func resourceIBMSatelliteHostAttachmentRead(d *schema.ResourceData, meta interface{}) error {
+	parts, err := flex.IdParts(d.Id())
+	if err != nil {
+		return err
+	}
+	if len(parts) < 2 {
+		return fmt.Errorf("[ERROR] Incorrect ID %s: Id should be a combination of location/hostID", d.Id())
+	}
+	location := parts[0]
+	id := parts[1]
+
+	satClient, err := meta.(conns.ClientSession).SatelliteClientSession()
+	if err != nil {
+		return err
+	}
+
+	hostOptions := &kubernetesserviceapiv1.GetSatelliteHostsOptions{
+		Controller: &location,
+	}
+	hostList, resp, err := satClient.GetSatelliteHosts(hostOptions)
+	if err != nil {
+		if resp != nil && resp.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("[ERROR] Error getting hosts of Satellite location (%s): %s\n%s", location, err, resp)
+	}
+
+	host := findSatelliteHost(hostList, id)
+	if host == nil {
+		log.Printf("[WARN] Satellite host (%s) not found in location (%s), removing from state", id, location)
+		d.SetId("")
+		return nil
+	}
+
+	d.Set(hostLocation, location)
+	d.Set(hostID, *host.ID)
+	d.Set("host_name", *host.Name)
+	if _, ok := d.GetOk("host_labels"); !ok {
+		labels := make([]string, 0, len(host.Labels))
+		for k, v := range host.Labels {
+			labels = append(labels, fmt.Sprintf("%s:%s", k, v))
+		}
+		d.Set("host_labels", labels)
+	}
+	if host.Health != nil && host.Health.Status != nil {
+		d.Set(hostState, *host.Health.Status)
+	}
+	if host.Assignment != nil {
+		// Keep the name or ID that was configured, so that either form matches the assignment
+		if cluster := satelliteAssignmentRef(d.Get(hostCluster).(string), host.Assignment.ClusterID, host.Assignment.ClusterName); cluster != "" {
+			d.Set(hostCluster, cluster)
+		}
+		if workerPool := satelliteAssignmentRef(d.Get(hostWorkerPool).(string), host.Assignment.WorkerPoolID, host.Assignment.WorkerPoolName); workerPool != "" {
+			d.Set(hostWorkerPool, workerPool)
+		}
+		if host.Assignment.Zone != nil {
+			d.Set(hostZone, *host.Assignment.Zone)
+		}
+	}
+
+	return nil
+}
+
+func resourceIBMSatelliteHostAttachmentDelete(d *schema.ResourceData, meta interface{}) error {
+	parts, err := flex.IdParts(d.Id())
+	if err != nil {
+		return err
+	}
+	location := parts[0]
+	id := parts[1]
+
+	satClient, err := meta.(conns.ClientSession).SatelliteClientSession()
+	if err != nil {
+		return err
+	}
+
+	removeSatHostOptions := &kubernetesserviceapiv1.RemoveSatelliteHostOptions{
+		Controller: &location,
+		HostID:     &id,
+	}
+	response, err := satClient.RemoveSatelliteHost(removeSatHostOptions)
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("[ERROR] Error Deleting Satellite Host: %s\n%s", err, response)
+	}
+
+	_, err = waitForHostRemoval(location, id, d.Timeout(schema.TimeoutDelete), meta)
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error waiting for host (%s) to be removed from location (%s): %s", id, location, err)
+	}
+
+	d.SetId("")
+	return nil
+}
+
+// satelliteAssignmentRef returns the configured reference when it is the ID or the name of the assigned object,
+// and the name of the object otherwise.
+func satelliteAssignmentRef(configured string, id, name *string) string {
+	if configured != "" && (configured == core.StringNilMapper(id) || configured == core.StringNilMapper(name)) {
+		return configured
+	}
+	if name != nil && *name != "" {
+		return *name
+	}
+	return core.StringNilMapper(id)
+}
+
+// getSatelliteHostScriptLocation returns the ID of the location that an attach host script was generated for.
+// The ID of the ibm_satellite_attach_host_script data source is the ID of that location.
+func getSatelliteHostScriptLocation(scriptID string, meta interface{}) (string, error) {
+	satClient, err := meta.(conns.ClientSession).SatelliteClientSession()
+	if err != nil {
+		return "", err
+	}
+	getSatLocOptions := &kubernetesserviceapiv1.GetSatelliteLocationOptions{
+		Controller: &scriptID,
+	}
+	locData, response, err := satClient.GetSatelliteLocation(getSatLocOptions)
+	if err != nil || locData == nil || locData.ID == nil {
+		return "", fmt.Errorf("[ERROR] Error getting the Satellite location of attach host script (%s): %s\n%s", scriptID, err, response)
+	}
+	return *locData.ID, nil
+}
+
+// findSatelliteHost returns the host with the given ID or name, or nil if the host is not in the list.
+func findSatelliteHost(hostList []kubernetesserviceapiv1.MultishiftQueueNode, id string) *kubernetesserviceapiv1.MultishiftQueueNode {
+	for i, h := range hostList {
+		if (h.ID != nil && *h.ID == id) || (h.Name != nil && *h.Name == id) {
+			return &hostList[i]
+		}
+	}
+	return nil
+}
+
+// selectSatelliteHost returns the first unassigned host, ordered by name, that is ready for assignment and
+// matches the host name and all labels of the selector.
+func selectSatelliteHost(hostList []kubernetesserviceapiv1.MultishiftQueueNode, hostName string, selector map[string]string) *kubernetesserviceapiv1.MultishiftQueueNode {
+	sort.SliceStable(hostList, func(i, j int) bool {
+		return core.StringNilMapper(hostList[i].Name) < core.StringNilMapper(hostList[j].Name)
+	})
+	for i, h := range hostList {
+		if h.ID == nil || h.Name == nil || h.Health == nil || h.Health.Status == nil {
+			continue
+		}
+		if hostName != "" && *h.Name != hostName {
+			continue
+		}
+		if *h.Health.Status != rsHostReadyStatus || (h.State != nil && *h.State == rsHostAssignedStatus) {
+			continue
+		}
+		matches := true
+		for k, v := range selector {
+			if h.Labels[k] != v {
+				matches = false
+				break
+			}
+		}
+		if matches {
+			return &hostList[i]
+		}
+	}
+	return nil
+}
+
+// waitForHostRegistrationAndAssign polls the hosts of the location until a matching host has registered and
+// assigns it. Selection and assignment are serialized per location so that parallel attachments with
+// overlapping selectors never claim the same host.
+func waitForHostRegistrationAndAssign(location, hostName string, selector map[string]string, hostAssignOptions *kubernetesserviceapiv1.CreateSatelliteAssignmentOptions, timeout time.Duration, meta interface{}) (*kubernetesserviceapiv1.MultishiftQueueNode, error) {
+	satClient, err := meta.(conns.ClientSession).SatelliteClientSession()
+	if err != nil {
+		return nil, err
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{rsHostWaitingStatus},
+		Target:  []string{rsHostAssignedStatus},
+		Refresh: func() (interface{}, string, error) {
+			mk := "satellite_host_attachment_" + location
+			conns.IbmMutexKV.Lock(mk)
+			defer conns.IbmMutexKV.Unlock(mk)
+
+			hostOptions := &kubernetesserviceapiv1.GetSatelliteHostsOptions{
+				Controller: &location,
+			}
+			hostList, resp, err := satClient.GetSatelliteHosts(hostOptions)
+			if err != nil {
+				if resp != nil && resp.StatusCode == 404 {
+					return nil, rsHostWaitingStatus, nil
+				}
+				return nil, "", fmt.Errorf("[ERROR] Error getting hosts of Satellite location (%s): %s\n%s", location, err, resp)
+			}
+
+			host := selectSatelliteHost(hostList, hostName, selector)
+			if host == nil {
+				log.Printf("[DEBUG] No registered host in location (%s) matches the labels %v yet", location, selector)
+				return nil, rsHostWaitingStatus, nil
+			}
+
+			hostAssignOptions.HostID = host.ID
+			_, response, err := satClient.CreateSatelliteAssignment(hostAssignOptions)
+			if err != nil {
+				return nil, "", fmt.Errorf("[ERROR] Error Assigning Satellite Host (%s): %s\n%s", *host.Name, err, response)
+			}
+			return host, rsHostAssignedStatus, nil
+		},
+		Timeout:    timeout,
+		Delay:      10 * time.Second,
+		MinTimeout: 30 * time.Second,
+	}
+
+	host, err := stateConf.WaitForState()
+	if err != nil {
+		return nil, err
+	}
+	return host.(*kubernetesserviceapiv1.MultishiftQueueNode), nil
+}
+
+func waitForAssignedHostNormal(location, id string, timeout time.Duration, meta interface{}) (interface{}, error) {
+	satClient, err := meta.(conns.ClientSession).SatelliteClientSession()
+	if err != nil {
+		return nil, err
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{rsHostReadyStatus, rsHostProvisioningStatus, rsHostUnknownStatus},
+		Target:  []string{rsHostNormalStatus},
+		Refresh: func() (interface{}, string, error) {
+			hostOptions := &kubernetesserviceapiv1.GetSatelliteHostsOptions{
+				Controller: &location,
+			}
+			hostList, resp, err := satClient.GetSatelliteHosts(hostOptions)
+			if err != nil {
+				return nil, "", fmt.Errorf("[ERROR] Error getting hosts of Satellite location (%s): %s\n%s", location, err, resp)
+			}
+			host := findSatelliteHost(hostList, id)
+			if host == nil {
+				return nil, "", fmt.Errorf("[ERROR] The satellite host (%s) is no longer attached to location (%s)", id, location)
+			}
+			if host.Health == nil || host.Health.Status == nil {
+				return host, rsHostUnknownStatus, nil
+			}
+			return host, *host.Health.Status, nil
+		},
+		Timeout:    timeout,
+		Delay:      60 * time.Second,
+		MinTimeout: 60 * time.Second,
+	}
+
+	return stateConf.WaitForState()
+}
+
+func waitForHostRemoval(location, id string, timeout time.Duration, meta interface{}) (interface{}, error) {
+	satClient, err := meta.(conns.ClientSession).SatelliteClientSession()
+	if err != nil {
+		return nil, err
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{rsHostAssignedStatus, rsHostUnassignedStatus},
+		Target:  []string{rsHostRemovedStatus},
+		Refresh: func() (interface{}, string, error) {
+			hostOptions := &kubernetesserviceapiv1.GetSatelliteHostsOptions{
+				Controller: &location,
+			}
+			hostList, resp, err := satClient.GetSatelliteHosts(hostOptions)
+			if err != nil {
+				if resp != nil && resp.StatusCode == 404 {
+					return location, rsHostRemovedStatus, nil
+				}
+				return nil, "", fmt.Errorf("[ERROR] Error getting hosts of Satellite location (%s): %s\n%s", location, err, resp)
+			}
+			host := findSatelliteHost(hostList, id)
+			if host == nil {
+				return location, rsHostRemovedStatus, nil
+			}
+			if host.State != nil && *host.State == rsHostAssignedStatus {
+				return host, rsHostAssignedStatus, nil
+			}
+			return host, rsHostUnassignedStatus, nil
+		},
+		Timeout:    timeout,
+		Delay:      10 * time.Second,
+		MinTimeout: 30 * time.Second,
+	}
+
+	return stateConf.WaitForState()
+}
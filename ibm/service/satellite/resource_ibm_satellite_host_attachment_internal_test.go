@@ -0,0 +1,48 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package satellite
+
+import (
+	"testing"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"gotest.tools/assert"
+)
+
+func TestValidateSatelliteHostLabel(t *testing.T) {
+	testcases := []struct {
+		label string
+		valid bool
+	}{
+		{label: "env:prod", valid: true},
+		{label: "cpu:4", valid: true},
+		{label: "env", valid: false},
+		{label: "env=prod", valid: false},
+		{label: ":prod", valid: false},
+		{label: "zone:us-south:1", valid: false},
+	}
+
+	for _, tc := range testcases {
+		_, errs := validateSatelliteHostLabel(tc.label, "host_labels")
+		assert.Equal(t, len(errs) == 0, tc.valid, tc.label)
+	}
+}
+
+func TestSatelliteAssignmentRef(t *testing.T) {
+	testcases := []struct {
+		configured string
+		expected   string
+	}{
+		{configured: "", expected: "mycluster"},
+		{configured: "mycluster", expected: "mycluster"},
+		{configured: "c1a2b3", expected: "c1a2b3"},
+		{configured: "othercluster", expected: "mycluster"},
+	}
+
+	for _, tc := range testcases {
+		ref := satelliteAssignmentRef(tc.configured, core.StringPtr("c1a2b3"), core.StringPtr("mycluster"))
+		assert.Equal(t, ref, tc.expected)
+	}
+	assert.Equal(t, satelliteAssignmentRef("", core.StringPtr("c1a2b3"), nil), "c1a2b3")
+}
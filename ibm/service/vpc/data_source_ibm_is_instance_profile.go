@@ -54,6 +54,61 @@ func DataSourceIBMISInstanceProfile() *schema.Resource {
 				},
 			},
 
+			"confidential_compute_modes": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The confidential compute modes supported by an instance with this profile.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"default": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The default confidential compute mode for this profile.",
+						},
+						"type": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The type for this profile field.",
+						},
+						"values": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Description: "The supported confidential compute modes.",
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+					},
+				},
+			},
+			"secure_boot_modes": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The secure boot modes supported by an instance with this profile.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"default": {
+							Type:        schema.TypeBool,
+							Computed:    true,
+							Description: "The default secure boot mode for this profile.",
+						},
+						"type": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The type for this profile field.",
+						},
+						"values": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Description: "The supported `enable_secure_boot` values for an instance using this profile.",
+							Elem: &schema.Schema{
+								Type: schema.TypeBool,
+							},
+						},
+					},
+				},
+			},
+
 			"bandwidth": {
 				Type:     schema.TypeList,
 				Computed: true,
@@ -750,6 +805,17 @@ func instanceProfileGet(d *schema.ResourceData, meta interface{}, name string) e
 		}
 	}
 
+	capabilities, err := getInstanceProfileSecurityCapabilities(sess, name)
+	if err != nil {
+		return err
+	}
+	if err = d.Set("confidential_compute_modes", flattenInstanceProfileConfidentialComputeModes(capabilities)); err != nil {
+		return err
+	}
+	if err = d.Set("secure_boot_modes", flattenInstanceProfileSecureBootModes(capabilities)); err != nil {
+		return err
+	}
+
 	if profile.VcpuCount != nil {
 		err = d.Set("vcpu_count", dataSourceInstanceProfileFlattenVcpuCount(*profile.VcpuCount.(*vpcv1.InstanceProfileVcpu)))
 		if err != nil {
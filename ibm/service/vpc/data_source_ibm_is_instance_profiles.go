@@ -597,6 +597,60 @@ func DataSourceIBMISInstanceProfiles() *schema.Resource {
 								},
 							},
 						},
+						"confidential_compute_modes": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Description: "The confidential compute modes supported by an instance with this profile.",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"default": {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: "The default confidential compute mode for this profile.",
+									},
+									"type": {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: "The type for this profile field.",
+									},
+									"values": {
+										Type:        schema.TypeList,
+										Computed:    true,
+										Description: "The supported confidential compute modes.",
+										Elem: &schema.Schema{
+											Type: schema.TypeString,
+										},
+									},
+								},
+							},
+						},
+						"secure_boot_modes": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Description: "The secure boot modes supported by an instance with this profile.",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"default": {
+										Type:        schema.TypeBool,
+										Computed:    true,
+										Description: "The default secure boot mode for this profile.",
+									},
+									"type": {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: "The type for this profile field.",
+									},
+									"values": {
+										Type:        schema.TypeList,
+										Computed:    true,
+										Description: "The supported `enable_secure_boot` values for an instance using this profile.",
+										Elem: &schema.Schema{
+											Type: schema.TypeBool,
+										},
+									},
+								},
+							},
+						},
 						"vcpu_manufacturer": &schema.Schema{
 							Type:     schema.TypeList,
 							Computed: true,
@@ -645,6 +699,10 @@ func instanceProfilesList(d *schema.ResourceData, meta interface{}) error {
 	if err != nil {
 		return fmt.Errorf("[ERROR] Error Fetching Instance Profiles %s\n%s", err, response)
 	}
+	capabilities, err := listInstanceProfileSecurityCapabilities(sess)
+	if err != nil {
+		return err
+	}
 	profilesInfo := make([]map[string]interface{}, 0)
 	for _, profile := range availableProfiles.Profiles {
 
@@ -748,6 +806,11 @@ func instanceProfilesList(d *schema.ResourceData, meta interface{}) error {
 			l["vcpu_manufacturer"] = vcpuManufacturerList
 		}
 
+		if profileCapabilities, ok := capabilities[*profile.Name]; ok {
+			l["confidential_compute_modes"] = flattenInstanceProfileConfidentialComputeModes(profileCapabilities)
+			l["secure_boot_modes"] = flattenInstanceProfileSecureBootModes(profileCapabilities)
+		}
+
 		if profile.Disks != nil {
 			l[isInstanceDisks] = dataSourceInstanceProfileFlattenDisks(profile.Disks)
 			if err != nil {
@@ -0,0 +1,467 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package vpc
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/IBM/vpc-go-sdk/vpcv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const (
+	isLBHealthDegraded = "degraded"
+)
+
+func DataSourceIBMISLBHealth() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceIBMISLBHealthRead,
+
+		Schema: map[string]*schema.Schema{
+			"lb": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The load balancer identifier.",
+			},
+			"operating_status": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The operating status of this load balancer.",
+			},
+			"provisioning_status": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The provisioning status of this load balancer.",
+			},
+			"health": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The aggregated health of the pools of this load balancer: ok, degraded, faulted or unknown.",
+			},
+			"statistics": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The connection statistics of this load balancer.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"active_connections": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "Number of active connections of this load balancer.",
+						},
+						"connection_rate": {
+							Type:        schema.TypeFloat,
+							Computed:    true,
+							Description: "Current connection rate (connections per second) of this load balancer.",
+						},
+						"data_processed_this_month": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "Total number of data processed (bytes) of this load balancer within current calendar month.",
+						},
+						"throughput": {
+							Type:        schema.TypeFloat,
+							Computed:    true,
+							Description: "Current throughput (Mbps) of this load balancer.",
+						},
+					},
+				},
+			},
+			"listeners": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The listeners of this load balancer.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The unique identifier for this load balancer listener.",
+						},
+						"connection_limit": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "The connection limit of the listener.",
+						},
+						"default_pool": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The unique identifier of the default pool of the listener.",
+						},
+						"port": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "The listener port number.",
+						},
+						"port_max": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "The inclusive upper bound of the range of ports used by this listener.",
+						},
+						"port_min": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "The inclusive lower bound of the range of ports used by this listener.",
+						},
+						"protocol": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The listener protocol.",
+						},
+						"provisioning_status": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The provisioning status of this listener.",
+						},
+					},
+				},
+			},
+			"pools": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The pools of this load balancer with the health of their members.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The unique identifier for this load balancer pool.",
+						},
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The name for this load balancer pool.",
+						},
+						"health": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The aggregated health of the pool members: ok, degraded, faulted or unknown.",
+						},
+						"provisioning_status": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The provisioning status of this pool.",
+						},
+						"healthy_member_count": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "The number of members with health ok.",
+						},
+						"unhealthy_member_count": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "The number of members with health faulted.",
+						},
+						"member_count": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "The number of members in the pool.",
+						},
+						"members": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Description: "The members of the pool.",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"id": {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: "The unique identifier for this load balancer pool member.",
+									},
+									"health": {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: "Health of the server member in the pool.",
+									},
+									"health_reasons": {
+										Type:        schema.TypeList,
+										Computed:    true,
+										Description: "The reasons for the current health of the member (if any).",
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"code": {
+													Type:        schema.TypeString,
+													Computed:    true,
+													Description: "A snake case string succinctly identifying the reason for this health state.",
+												},
+												"message": {
+													Type:        schema.TypeString,
+													Computed:    true,
+													Description: "An explanation of the reason for this health state.",
+												},
+												"more_info": {
+													Type:        schema.TypeString,
+													Computed:    true,
+													Description: "Link to documentation about the reason for this health state.",
+												},
+											},
+										},
+									},
+									"port": {
+										Type:        schema.TypeInt,
+										Computed:    true,
+										Description: "The port number of the application running in the server member.",
+									},
+									"provisioning_status": {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: "The provisioning status of this member.",
+									},
+									"target_address": {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: "The IP address of the pool member target.",
+									},
+									"target_id": {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: "The unique identifier of the pool member target instance.",
+									},
+									"weight": {
+										Type:        schema.TypeInt,
+										Computed:    true,
+										Description: "Weight of the server member.",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// lbPoolMemberHealthCollection holds the members of a pool with their health reasons.
+type lbPoolMemberHealthCollection struct {
+	Members []lbPoolMemberHealth `json:"members"`
+}
+
+type lbPoolMemberHealth struct {
+	ID            *string `json:"id,omitempty"`
+	Health        *string `json:"health,omitempty"`
+	HealthReasons []struct {
+		Code     *string `json:"code,omitempty"`
+		Message  *string `json:"message,omitempty"`
+		MoreInfo *string `json:"more_info,omitempty"`
+	} `json:"health_reasons,omitempty"`
+	Port               *int64  `json:"port,omitempty"`
+	ProvisioningStatus *string `json:"provisioning_status,omitempty"`
+	Target             *struct {
+		Address *string `json:"address,omitempty"`
+		ID      *string `json:"id,omitempty"`
+	} `json:"target,omitempty"`
+	Weight *int64 `json:"weight,omitempty"`
+}
+
+func dataSourceIBMISLBHealthRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sess, err := vpcClient(meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	lbID := d.Get("lb").(string)
+
+	getLoadBalancerOptions := &vpcv1.GetLoadBalancerOptions{
+		ID: &lbID,
+	}
+	lb, response, err := sess.GetLoadBalancerWithContext(context, getLoadBalancerOptions)
+	if err != nil {
+		log.Printf("[DEBUG] GetLoadBalancerWithContext failed %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error getting Load Balancer (%s): %s\n%s", lbID, err, response))
+	}
+	d.SetId(*lb.ID)
+	d.Set("operating_status", lb.OperatingStatus)
+	d.Set("provisioning_status", lb.ProvisioningStatus)
+
+	getLoadBalancerStatisticsOptions := &vpcv1.GetLoadBalancerStatisticsOptions{
+		ID: &lbID,
+	}
+	statistics, response, err := sess.GetLoadBalancerStatisticsWithContext(context, getLoadBalancerStatisticsOptions)
+	if err != nil {
+		log.Printf("[DEBUG] GetLoadBalancerStatisticsWithContext failed %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error getting Load Balancer (%s) statistics: %s\n%s", lbID, err, response))
+	}
+	statisticsMap := map[string]interface{}{}
+	if statistics.ActiveConnections != nil {
+		statisticsMap["active_connections"] = *statistics.ActiveConnections
+	}
+	if statistics.ConnectionRate != nil {
+		statisticsMap["connection_rate"] = *statistics.ConnectionRate
+	}
+	if statistics.DataProcessedThisMonth != nil {
+		statisticsMap["data_processed_this_month"] = *statistics.DataProcessedThisMonth
+	}
+	if statistics.Throughput != nil {
+		statisticsMap["throughput"] = *statistics.Throughput
+	}
+	if err = d.Set("statistics", []map[string]interface{}{statisticsMap}); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting statistics %s", err))
+	}
+
+	listLoadBalancerListenersOptions := &vpcv1.ListLoadBalancerListenersOptions{
+		LoadBalancerID: &lbID,
+	}
+	listenerCollection, response, err := sess.ListLoadBalancerListenersWithContext(context, listLoadBalancerListenersOptions)
+	if err != nil {
+		log.Printf("[DEBUG] ListLoadBalancerListenersWithContext failed %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error listing Load Balancer (%s) listeners: %s\n%s", lbID, err, response))
+	}
+	listeners := []map[string]interface{}{}
+	for _, listener := range listenerCollection.Listeners {
+		listeners = append(listeners, dataSourceIBMISLBHealthListenerToMap(listener))
+	}
+	if err = d.Set("listeners", listeners); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting listeners %s", err))
+	}
+
+	listLoadBalancerPoolsOptions := &vpcv1.ListLoadBalancerPoolsOptions{
+		LoadBalancerID: &lbID,
+	}
+	poolCollection, response, err := sess.ListLoadBalancerPoolsWithContext(context, listLoadBalancerPoolsOptions)
+	if err != nil {
+		log.Printf("[DEBUG] ListLoadBalancerPoolsWithContext failed %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error listing Load Balancer (%s) pools: %s\n%s", lbID, err, response))
+	}
+	pools := []map[string]interface{}{}
+	poolHealths := []string{}
+	for _, pool := range poolCollection.Pools {
+		memberCollection := &lbPoolMemberHealthCollection{}
+		response, err := vpcRawGet(sess, `/load_balancers/{load_balancer_id}/pools/{pool_id}/members`, map[string]string{"load_balancer_id": lbID, "pool_id": *pool.ID}, memberCollection)
+		if err != nil {
+			log.Printf("[DEBUG] ListLoadBalancerPoolMembers failed %s\n%s", err, response)
+			return diag.FromErr(fmt.Errorf("[ERROR] Error listing Load Balancer Pool (%s) members: %s\n%s", *pool.ID, err, response))
+		}
+		poolMap := dataSourceIBMISLBHealthPoolToMap(pool, memberCollection.Members)
+		poolHealths = append(poolHealths, poolMap["health"].(string))
+		pools = append(pools, poolMap)
+	}
+	if err = d.Set("pools", pools); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting pools %s", err))
+	}
+	d.Set("health", aggregateLBHealth(poolHealths))
+
+	return nil
+}
+
+// aggregateLBHealth returns ok or faulted when all healths agree, degraded when some are ok and unknown otherwise.
+func aggregateLBHealth(healths []string) string {
+	counts := map[string]int{}
+	for _, health := range healths {
+		counts[health]++
+	}
+	switch {
+	case len(healths) == 0:
+		return isLBPoolMemberHealthUnknown
+	case counts[isLBPoolMemberHealthOk] == len(healths):
+		return isLBPoolMemberHealthOk
+	case counts[isLBPoolMemberHealthFaulted] == len(healths):
+		return isLBPoolMemberHealthFaulted
+	case counts[isLBPoolMemberHealthOk] > 0 || counts[isLBHealthDegraded] > 0:
+		return isLBHealthDegraded
+	}
+	return isLBPoolMemberHealthUnknown
+}
+
+func dataSourceIBMISLBHealthListenerToMap(listener vpcv1.LoadBalancerListener) map[string]interface{} {
+	listenerMap := map[string]interface{}{}
+	if listener.ID != nil {
+		listenerMap["id"] = *listener.ID
+	}
+	if listener.ConnectionLimit != nil {
+		listenerMap["connection_limit"] = *listener.ConnectionLimit
+	}
+	if listener.DefaultPool != nil && listener.DefaultPool.ID != nil {
+		listenerMap["default_pool"] = *listener.DefaultPool.ID
+	}
+	if listener.Port != nil {
+		listenerMap["port"] = *listener.Port
+	}
+	if listener.PortMax != nil {
+		listenerMap["port_max"] = *listener.PortMax
+	}
+	if listener.PortMin != nil {
+		listenerMap["port_min"] = *listener.PortMin
+	}
+	if listener.Protocol != nil {
+		listenerMap["protocol"] = *listener.Protocol
+	}
+	if listener.ProvisioningStatus != nil {
+		listenerMap["provisioning_status"] = *listener.ProvisioningStatus
+	}
+	return listenerMap
+}
+
+func dataSourceIBMISLBHealthPoolToMap(pool vpcv1.LoadBalancerPool, members []lbPoolMemberHealth) map[string]interface{} {
+	poolMap := map[string]interface{}{}
+	if pool.ID != nil {
+		poolMap["id"] = *pool.ID
+	}
+	if pool.Name != nil {
+		poolMap["name"] = *pool.Name
+	}
+	if pool.ProvisioningStatus != nil {
+		poolMap["provisioning_status"] = *pool.ProvisioningStatus
+	}
+	healthy, unhealthy := 0, 0
+	memberHealths := []string{}
+	memberList := []map[string]interface{}{}
+	for _, member := range members {
+		memberMap := map[string]interface{}{}
+		if member.ID != nil {
+			memberMap["id"] = *member.ID
+		}
+		if member.Health != nil {
+			memberMap["health"] = *member.Health
+			memberHealths = append(memberHealths, *member.Health)
+			switch *member.Health {
+			case isLBPoolMemberHealthOk:
+				healthy++
+			case isLBPoolMemberHealthFaulted:
+				unhealthy++
+			}
+		}
+		reasons := []map[string]interface{}{}
+		for _, reason := range member.HealthReasons {
+			reasonMap := map[string]interface{}{}
+			if reason.Code != nil {
+				reasonMap["code"] = *reason.Code
+			}
+			if reason.Message != nil {
+				reasonMap["message"] = *reason.Message
+			}
+			if reason.MoreInfo != nil {
+				reasonMap["more_info"] = *reason.MoreInfo
+			}
+			reasons = append(reasons, reasonMap)
+		}
+		memberMap["health_reasons"] = reasons
+		if member.Port != nil {
+			memberMap["port"] = *member.Port
+		}
+		if member.ProvisioningStatus != nil {
+			memberMap["provisioning_status"] = *member.ProvisioningStatus
+		}
+		if member.Target != nil {
+			if member.Target.Address != nil {
+				memberMap["target_address"] = *member.Target.Address
+			}
+			if member.Target.ID != nil {
+				memberMap["target_id"] = *member.Target.ID
+			}
+		}
+		if member.Weight != nil {
+			memberMap["weight"] = *member.Weight
+		}
+		memberList = append(memberList, memberMap)
+	}
+	poolMap["members"] = memberList
+	poolMap["member_count"] = len(members)
+	poolMap["healthy_member_count"] = healthy
+	poolMap["unhealthy_member_count"] = unhealthy
+	poolMap["health"] = aggregateLBHealth(memberHealths)
+	return poolMap
+}
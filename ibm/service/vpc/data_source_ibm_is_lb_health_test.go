@@ -0,0 +1,48 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package vpc_test
+
+import (
+	"fmt"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIBMISLBHealthDataSource_basic(t *testing.T) {
+	vpcname := fmt.Sprintf("tflbh-vpc-%d", acctest.RandIntRange(10, 100))
+	subnetname := fmt.Sprintf("tflbh-name-%d", acctest.RandIntRange(10, 100))
+	name := fmt.Sprintf("tfcreate%d", acctest.RandIntRange(10, 100))
+	poolName := fmt.Sprintf("tflbpoolc%d", acctest.RandIntRange(10, 100))
+	port := "8080"
+	address := "127.0.0.1"
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMISLBHealthDataSourceConfig(vpcname, subnetname, acc.ISZoneName, acc.ISCIDR, name, poolName, port, address),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.ibm_is_lb_health.testacc_lb_health", "operating_status"),
+					resource.TestCheckResourceAttrSet("data.ibm_is_lb_health.testacc_lb_health", "health"),
+					resource.TestCheckResourceAttr("data.ibm_is_lb_health.testacc_lb_health", "statistics.#", "1"),
+					resource.TestCheckResourceAttr("data.ibm_is_lb_health.testacc_lb_health", "pools.#", "1"),
+					resource.TestCheckResourceAttr("data.ibm_is_lb_health.testacc_lb_health", "pools.0.member_count", "1"),
+					resource.TestCheckResourceAttrSet("data.ibm_is_lb_health.testacc_lb_health", "pools.0.members.0.health"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMISLBHealthDataSourceConfig(vpcname, subnetname, zone, cidr, name, poolName, port, address string) string {
+	return testAccCheckIBMISLBPoolMemberConfig(vpcname, subnetname, zone, cidr, name, poolName, port, address) + `
+	data "ibm_is_lb_health" "testacc_lb_health" {
+		lb = ibm_is_lb.testacc_LB.id
+		depends_on = [ibm_is_lb_pool_member.testacc_lb_mem]
+	}
+	`
+}
@@ -344,6 +344,73 @@ func DataSourceIbmIsShare() *schema.Resource {
 				Computed:    true,
 				Description: "The access control mode for the share",
 			},
+			"accessor_binding_role": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The accessor binding role of this share.",
+			},
+			"accessor_bindings": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The accessor bindings for this share.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"href": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The URL for this share accessor binding.",
+						},
+						"id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The unique identifier for this share accessor binding.",
+						},
+					},
+				},
+			},
+			"origin_share": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The origin share this accessor share is referring to.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"crn": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The CRN of the origin share.",
+						},
+						"href": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The URL for the origin share.",
+						},
+						"id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The unique identifier of the origin share.",
+						},
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The name of the origin share.",
+						},
+					},
+				},
+			},
+			"allowed_transfer_protocols": {
+				Type:        schema.TypeSet,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Set:         schema.HashString,
+				Description: "The transfer protocols allowed for mount targets of this share.",
+			},
+			"allowed_transit_encryption_modes": {
+				Type:        schema.TypeSet,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Set:         schema.HashString,
+				Description: "The transit encryption modes allowed for mount targets of this share.",
+			},
 			isFileShareAccessTags: {
 				Type:        schema.TypeSet,
 				Computed:    true,
@@ -522,6 +589,13 @@ func dataSourceIbmIsShareRead(context context.Context, d *schema.ResourceData, m
 			return diag.FromErr(fmt.Errorf("Error setting zone %s", err))
 		}
 	}
+	accessorOptions, err := getShareAccessorOptions(vpcClient, *share.ID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if err = setShareAccessorOptions(d, accessorOptions); err != nil {
+		return diag.FromErr(err)
+	}
 
 	accesstags, err := flex.GetGlobalTagsUsingCRN(meta, *share.CRN, "", isAccessTagType)
 	if err != nil {
@@ -0,0 +1,263 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package vpc
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func DataSourceIBMIsShareAccessorBindings() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceIBMIsShareAccessorBindingsRead,
+
+		Schema: map[string]*schema.Schema{
+			"share": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The file share identifier.",
+			},
+			"accessor_bindings": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Collection of accessor bindings of the file share.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"accessor": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Description: "The accessor for this share accessor binding. The resource may be in another account.",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"crn": {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: "The CRN for the accessor share.",
+									},
+									"href": {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: "The URL for the accessor share. Absent if the accessor share is in another account.",
+									},
+									"id": {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: "The unique identifier for the accessor share.",
+									},
+									"name": {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: "The name for the accessor share. Absent if the accessor share is in another account.",
+									},
+									"remote": {
+										Type:        schema.TypeList,
+										Computed:    true,
+										Description: "If present, this property indicates that the accessor share is in another account.",
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"account": {
+													Type:        schema.TypeList,
+													Computed:    true,
+													Description: "The account of the accessor share.",
+													Elem: &schema.Resource{
+														Schema: map[string]*schema.Schema{
+															"id": {
+																Type:        schema.TypeString,
+																Computed:    true,
+																Description: "The unique identifier for the account.",
+															},
+															"resource_type": {
+																Type:        schema.TypeString,
+																Computed:    true,
+																Description: "The resource type.",
+															},
+														},
+													},
+												},
+												"region": {
+													Type:        schema.TypeString,
+													Computed:    true,
+													Description: "The name of the region of the accessor share.",
+												},
+											},
+										},
+									},
+									"resource_type": {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: "The resource type.",
+									},
+								},
+							},
+						},
+						"created_at": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The date and time that the share accessor binding was created.",
+						},
+						"href": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The URL for this share accessor binding.",
+						},
+						"id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The unique identifier for this share accessor binding.",
+						},
+						"lifecycle_state": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The lifecycle state of the file share accessor binding.",
+						},
+						"resource_type": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The resource type.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// shareAccessorBindingCollection holds a page of the accessor bindings of a file share.
+type shareAccessorBindingCollection struct {
+	AccessorBindings []shareAccessorBinding `json:"accessor_bindings"`
+	Next             *struct {
+		Href *string `json:"href"`
+	} `json:"next,omitempty"`
+}
+
+type shareAccessorBinding struct {
+	Accessor *struct {
+		CRN    *string `json:"crn,omitempty"`
+		Href   *string `json:"href,omitempty"`
+		ID     *string `json:"id,omitempty"`
+		Name   *string `json:"name,omitempty"`
+		Remote *struct {
+			Account *struct {
+				ID           *string `json:"id,omitempty"`
+				ResourceType *string `json:"resource_type,omitempty"`
+			} `json:"account,omitempty"`
+			Region *struct {
+				Name *string `json:"name,omitempty"`
+			} `json:"region,omitempty"`
+		} `json:"remote,omitempty"`
+		ResourceType *string `json:"resource_type,omitempty"`
+	} `json:"accessor,omitempty"`
+	CreatedAt      *string `json:"created_at,omitempty"`
+	Href           *string `json:"href,omitempty"`
+	ID             *string `json:"id,omitempty"`
+	LifecycleState *string `json:"lifecycle_state,omitempty"`
+	ResourceType   *string `json:"resource_type,omitempty"`
+}
+
+func dataSourceIBMIsShareAccessorBindingsRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	vpcClient, err := meta.(conns.ClientSession).VpcV1API()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	shareID := d.Get("share").(string)
+	start := ""
+	allrecs := []shareAccessorBinding{}
+	for {
+		queryParams := map[string]string{}
+		if start != "" {
+			queryParams["start"] = start
+		}
+		collection := &shareAccessorBindingCollection{}
+		response, err := vpcRawGetWithQuery(vpcClient, `/shares/{id}/accessor_bindings`, map[string]string{"id": shareID}, queryParams, collection)
+		if err != nil {
+			log.Printf("[DEBUG] ListShareAccessorBindings failed %s\n%s", err, response)
+			return diag.FromErr(fmt.Errorf("[ERROR] Error listing accessor bindings of file share (%s): %s", shareID, err))
+		}
+		start = flex.GetNext(collection.Next)
+		allrecs = append(allrecs, collection.AccessorBindings...)
+		if start == "" {
+			break
+		}
+	}
+	d.SetId(dataSourceIBMIsShareAccessorBindingsID(d))
+
+	accessorBindings := []map[string]interface{}{}
+	for _, binding := range allrecs {
+		accessorBindings = append(accessorBindings, dataSourceShareAccessorBindingToMap(binding))
+	}
+	if err = d.Set("accessor_bindings", accessorBindings); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting accessor_bindings %s", err))
+	}
+
+	return nil
+}
+
+// dataSourceIBMIsShareAccessorBindingsID returns a reasonable ID for the list.
+func dataSourceIBMIsShareAccessorBindingsID(d *schema.ResourceData) string {
+	return time.Now().UTC().String()
+}
+
+func dataSourceShareAccessorBindingToMap(binding shareAccessorBinding) map[string]interface{} {
+	bindingMap := map[string]interface{}{}
+	if binding.Accessor != nil {
+		accessor := map[string]interface{}{}
+		if binding.Accessor.CRN != nil {
+			accessor["crn"] = *binding.Accessor.CRN
+		}
+		if binding.Accessor.Href != nil {
+			accessor["href"] = *binding.Accessor.Href
+		}
+		if binding.Accessor.ID != nil {
+			accessor["id"] = *binding.Accessor.ID
+		}
+		if binding.Accessor.Name != nil {
+			accessor["name"] = *binding.Accessor.Name
+		}
+		if binding.Accessor.Remote != nil {
+			remote := map[string]interface{}{}
+			if binding.Accessor.Remote.Account != nil {
+				account := map[string]interface{}{}
+				if binding.Accessor.Remote.Account.ID != nil {
+					account["id"] = *binding.Accessor.Remote.Account.ID
+				}
+				if binding.Accessor.Remote.Account.ResourceType != nil {
+					account["resource_type"] = *binding.Accessor.Remote.Account.ResourceType
+				}
+				remote["account"] = []map[string]interface{}{account}
+			}
+			if binding.Accessor.Remote.Region != nil && binding.Accessor.Remote.Region.Name != nil {
+				remote["region"] = *binding.Accessor.Remote.Region.Name
+			}
+			accessor["remote"] = []map[string]interface{}{remote}
+		}
+		if binding.Accessor.ResourceType != nil {
+			accessor["resource_type"] = *binding.Accessor.ResourceType
+		}
+		bindingMap["accessor"] = []map[string]interface{}{accessor}
+	}
+	if binding.CreatedAt != nil {
+		bindingMap["created_at"] = *binding.CreatedAt
+	}
+	if binding.Href != nil {
+		bindingMap["href"] = *binding.Href
+	}
+	if binding.ID != nil {
+		bindingMap["id"] = *binding.ID
+	}
+	if binding.LifecycleState != nil {
+		bindingMap["lifecycle_state"] = *binding.LifecycleState
+	}
+	if binding.ResourceType != nil {
+		bindingMap["resource_type"] = *binding.ResourceType
+	}
+	return bindingMap
+}
@@ -0,0 +1,60 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package vpc_test
+
+import (
+	"fmt"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIBMIsShareAccessorBindingsDataSource(t *testing.T) {
+	shareName := fmt.Sprintf("tf-fs-name-%d", acctest.RandIntRange(10, 100))
+	accessorName := fmt.Sprintf("tf-fs-accessor-%d", acctest.RandIntRange(10, 100))
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMIsShareAccessorBindingsDataSourceConfigBasic(shareName, accessorName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_is_share.is_share_accessor", "accessor_binding_role", "accessor"),
+					resource.TestCheckResourceAttrPair("ibm_is_share.is_share_accessor", "origin_share.0.id", "ibm_is_share.is_share", "id"),
+					resource.TestCheckResourceAttr("data.ibm_is_share_accessor_bindings.is_share_accessor_bindings", "accessor_bindings.#", "1"),
+					resource.TestCheckResourceAttrPair("data.ibm_is_share_accessor_bindings.is_share_accessor_bindings", "accessor_bindings.0.accessor.0.id", "ibm_is_share.is_share_accessor", "id"),
+					resource.TestCheckResourceAttrSet("data.ibm_is_share_accessor_bindings.is_share_accessor_bindings", "accessor_bindings.0.created_at"),
+					resource.TestCheckResourceAttrSet("data.ibm_is_share_accessor_bindings.is_share_accessor_bindings", "accessor_bindings.0.lifecycle_state"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMIsShareAccessorBindingsDataSourceConfigBasic(sname, accessorName string) string {
+	return fmt.Sprintf(`
+		resource "ibm_is_share" "is_share" {
+			access_control_mode = "security_group"
+			allowed_transit_encryption_modes = ["user_managed", "none"]
+			zone = "us-south-2"
+			size = 200
+			name = "%s"
+			profile = "%s"
+		}
+
+		resource "ibm_is_share" "is_share_accessor" {
+			name = "%s"
+			origin_share {
+				crn = ibm_is_share.is_share.crn
+			}
+		}
+
+		data "ibm_is_share_accessor_bindings" "is_share_accessor_bindings" {
+			share = ibm_is_share.is_share.id
+			depends_on = [ibm_is_share.is_share_accessor]
+		}
+	`, sname, acc.ShareProfileName, accessorName)
+}
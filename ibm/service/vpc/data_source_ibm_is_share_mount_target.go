@@ -45,6 +45,16 @@ func DataSourceIBMIsShareTarget() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"transfer_protocol": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The transfer protocol used by this mount target.",
+			},
+			"access_protocol": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The protocol used to access the share for this mount target.",
+			},
 			"access_control_mode": {
 				Type:        schema.TypeString,
 				Computed:    true,
@@ -284,6 +294,18 @@ func dataSourceIBMIsShareTargetRead(context context.Context, d *schema.ResourceD
 	}
 
 	d.SetId(fmt.Sprintf("%s/%s", share_id, *shareTarget.ID))
+	protocols := &shareMountTargetProtocols{}
+	response, err := vpcRawGet(vpcClient, `/shares/{share_id}/mount_targets/{id}`, map[string]string{"share_id": share_id, "id": *shareTarget.ID}, protocols)
+	if err != nil {
+		log.Printf("[DEBUG] Getting share mount target protocols failed %s\n%s", err, response)
+		return diag.FromErr(err)
+	}
+	if protocols.AccessProtocol != nil {
+		d.Set("access_protocol", *protocols.AccessProtocol)
+	}
+	if protocols.TransferProtocol != nil {
+		d.Set("transfer_protocol", *protocols.TransferProtocol)
+	}
 	if shareTarget.AccessControlMode != nil {
 		d.Set("access_control_mode", *shareTarget.AccessControlMode)
 	}
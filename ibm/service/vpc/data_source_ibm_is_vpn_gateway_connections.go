@@ -222,7 +222,9 @@ func dataSourceIBMVPNGatewayConnectionsRead(d *schema.ResourceData, meta interfa
 		}
 		gatewayconnection[isVPNGatewayConnectionMode] = *data.Mode
 		gatewayconnection[isVPNGatewayConnectionName] = *data.Name
-		gatewayconnection[isVPNGatewayConnectionPeerAddress] = *data.PeerAddress
+		if data.PeerAddress != nil {
+			gatewayconnection[isVPNGatewayConnectionPeerAddress] = *data.PeerAddress
+		}
 		gatewayconnection[isVPNGatewayConnectionResourcetype] = *data.ResourceType
 		gatewayconnection[isVPNGatewayConnectionStatus] = *data.Status
 		gatewayconnection[isVPNGatewayConnectionStatusreasons] = resourceVPNGatewayConnectionFlattenLifecycleReasons(data.StatusReasons)
@@ -14,6 +14,7 @@ import (
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	"github.com/IBM/go-sdk-core/v5/core"
 	"github.com/IBM/vpc-go-sdk/vpcv1"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
@@ -125,6 +126,8 @@ const (
 	isInstanceMetadataServiceEnabled1     = "enabled"
 	isInstanceMetadataServiceProtocol     = "protocol"
 	isInstanceMetadataServiceRespHopLimit = "response_hop_limit"
+	isInstanceConfidentialComputeMode     = "confidential_compute_mode"
+	isInstanceEnableSecureBoot            = "enable_secure_boot"
 )
 
 func ResourceIBMISInstance() *schema.Resource {
@@ -182,6 +185,10 @@ func ResourceIBMISInstance() *schema.Resource {
 				func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
 					return flex.ResourceValidateAccessTags(diff, v)
 				}),
+			customdiff.Sequence(
+				func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
+					return ResourceValidateInstanceSecurityOptions(diff, v, isInstanceProfile)
+				}),
 		),
 
 		Schema: map[string]*schema.Schema{
@@ -235,6 +242,19 @@ func ResourceIBMISInstance() *schema.Resource {
 				Optional:    true,
 				Description: "Profile info",
 			},
+			isInstanceConfidentialComputeMode: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validate.InvokeValidator("ibm_is_instance", isInstanceConfidentialComputeMode),
+				Description:  "The confidential compute mode to use for this virtual server instance. Changing it stops and starts the instance",
+			},
+			isInstanceEnableSecureBoot: {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Computed:    true,
+				Description: "Indicates whether secure boot is enabled for this virtual server instance. Changing it stops and starts the instance",
+			},
 			isInstanceDefaultTrustedProfileAutoLink: {
 				Type:         schema.TypeBool,
 				Optional:     true,
@@ -1094,6 +1114,14 @@ func ResourceIBMISInstanceValidator() *validate.ResourceValidator {
 			MinValueLength:             1,
 			MaxValueLength:             128})
 
+	validateSchema = append(validateSchema,
+		validate.ValidateSchema{
+			Identifier:                 isInstanceConfidentialComputeMode,
+			ValidateFunctionIdentifier: validate.ValidateAllowedStringValue,
+			Type:                       validate.TypeString,
+			Optional:                   true,
+			AllowedValues:              "disabled, sgx, tdx"})
+
 	ibmISInstanceValidator := validate.ResourceValidator{ResourceName: "ibm_is_instance", Schema: validateSchema}
 	return &ibmISInstanceValidator
 }
@@ -1431,7 +1459,7 @@ func instanceCreateByImage(d *schema.ResourceData, meta interface{}, profile, na
 	}
 
 	options := &vpcv1.CreateInstanceOptions{
-		InstancePrototype: instanceproto,
+		InstancePrototype: instancePrototypeWithSecurity(d, instanceproto),
 	}
 
 	instance, response, err := sess.CreateInstance(options)
@@ -1804,7 +1832,7 @@ func instanceCreateByCatalogOffering(d *schema.ResourceData, meta interface{}, p
 	}
 
 	options := &vpcv1.CreateInstanceOptions{
-		InstancePrototype: instanceproto,
+		InstancePrototype: instancePrototypeWithSecurity(d, instanceproto),
 	}
 
 	instance, response, err := sess.CreateInstance(options)
@@ -2174,7 +2202,7 @@ func instanceCreateByTemplate(d *schema.ResourceData, meta interface{}, profile,
 	}
 
 	options := &vpcv1.CreateInstanceOptions{
-		InstancePrototype: instanceproto,
+		InstancePrototype: instancePrototypeWithSecurity(d, instanceproto),
 	}
 
 	instance, response, err := sess.CreateInstance(options)
@@ -2547,7 +2575,7 @@ func instanceCreateBySnapshot(d *schema.ResourceData, meta interface{}, profile,
 	}
 
 	options := &vpcv1.CreateInstanceOptions{
-		InstancePrototype: instanceproto,
+		InstancePrototype: instancePrototypeWithSecurity(d, instanceproto),
 	}
 
 	instance, response, err := sess.CreateInstance(options)
@@ -2885,7 +2913,7 @@ func instanceCreateByVolume(d *schema.ResourceData, meta interface{}, profile, n
 	}
 
 	options := &vpcv1.CreateInstanceOptions{
-		InstancePrototype: instanceproto,
+		InstancePrototype: instancePrototypeWithSecurity(d, instanceproto),
 	}
 
 	instance, response, err := sess.CreateInstance(options)
@@ -3140,6 +3168,11 @@ func instanceGet(d *schema.ResourceData, meta interface{}, id string) error {
 	if instance.Profile != nil {
 		d.Set(isInstanceProfile, *instance.Profile.Name)
 	}
+	securityOptions, err := getInstanceSecurityOptions(instanceC, id)
+	if err != nil {
+		return err
+	}
+	setInstanceSecurityOptions(d, securityOptions)
 	cpuList := make([]map[string]interface{}, 0)
 	if instance.Vcpu != nil {
 		currentCPU := map[string]interface{}{}
@@ -4111,7 +4144,7 @@ func instanceUpdate(d *schema.ResourceData, meta interface{}) error {
 		}
 	}
 
-	if d.HasChange(isInstanceProfile) && !d.IsNewResource() {
+	if (d.HasChange(isInstanceProfile) || d.HasChange(isInstanceConfidentialComputeMode) || d.HasChange(isInstanceEnableSecureBoot)) && !d.IsNewResource() {
 
 		getinsOptions := &vpcv1.GetInstanceOptions{
 			ID: &id,
@@ -4148,17 +4181,23 @@ func instanceUpdate(d *schema.ResourceData, meta interface{}) error {
 			ID: &id,
 		}
 
-		instanceProfile := d.Get(isInstanceProfile).(string)
-		profile := &vpcv1.InstancePatchProfile{
-			Name: &instanceProfile,
-		}
-		instancePatchModel := &vpcv1.InstancePatch{
-			Profile: profile,
+		instancePatchModel := &vpcv1.InstancePatch{}
+		if d.HasChange(isInstanceProfile) {
+			instanceProfile := d.Get(isInstanceProfile).(string)
+			instancePatchModel.Profile = &vpcv1.InstancePatchProfile{
+				Name: &instanceProfile,
+			}
 		}
 		instancePatch, err := instancePatchModel.AsPatch()
 		if err != nil {
 			return fmt.Errorf("[ERROR] Error calling asPatch for InstancePatch: %s", err)
 		}
+		if d.HasChange(isInstanceConfidentialComputeMode) {
+			instancePatch[isInstanceConfidentialComputeMode] = d.Get(isInstanceConfidentialComputeMode).(string)
+		}
+		if d.HasChange(isInstanceEnableSecureBoot) {
+			instancePatch[isInstanceEnableSecureBoot] = d.Get(isInstanceEnableSecureBoot).(bool)
+		}
 		updnetoptions.InstancePatch = instancePatch
 
 		_, response, err = instanceC.UpdateInstance(updnetoptions)
@@ -4619,3 +4658,168 @@ func GetInstanceMetadataServiceOptions(d *schema.ResourceData) (metadataService
 	}
 	return nil
 }
+
+// instanceSecurityOptions holds the confidential computing and secure boot properties of an instance or instance template.
+type instanceSecurityOptions struct {
+	ConfidentialComputeMode *string `json:"confidential_compute_mode,omitempty"`
+	EnableSecureBoot        *bool   `json:"enable_secure_boot,omitempty"`
+}
+
+func expandInstanceSecurityOptions(d *schema.ResourceData) (instanceSecurityOptions, bool) {
+	options := instanceSecurityOptions{}
+	if mode, ok := d.GetOk(isInstanceConfidentialComputeMode); ok {
+		options.ConfidentialComputeMode = core.StringPtr(mode.(string))
+	}
+	if secureBoot, ok := d.GetOkExists(isInstanceEnableSecureBoot); ok {
+		options.EnableSecureBoot = core.BoolPtr(secureBoot.(bool))
+	}
+	return options, options.ConfidentialComputeMode != nil || options.EnableSecureBoot != nil
+}
+
+// instancePrototypeWithSecurity adds the confidential computing and secure boot arguments to the prototype when they are set.
+func instancePrototypeWithSecurity(d *schema.ResourceData, prototype vpcv1.InstancePrototypeIntf) vpcv1.InstancePrototypeIntf {
+	if options, ok := expandInstanceSecurityOptions(d); ok {
+		return &instancePrototypeWithRawFields{InstancePrototypeIntf: prototype, vpcRawFields: vpcRawFields{fields: options}}
+	}
+	return prototype
+}
+
+// instanceTemplatePrototypeWithSecurity adds the confidential computing and secure boot arguments to the prototype when they are set.
+func instanceTemplatePrototypeWithSecurity(d *schema.ResourceData, prototype vpcv1.InstanceTemplatePrototypeIntf) vpcv1.InstanceTemplatePrototypeIntf {
+	if options, ok := expandInstanceSecurityOptions(d); ok {
+		return &instanceTemplatePrototypeWithRawFields{InstanceTemplatePrototypeIntf: prototype, vpcRawFields: vpcRawFields{fields: options}}
+	}
+	return prototype
+}
+
+func getInstanceSecurityOptions(sess *vpcv1.VpcV1, id string) (*instanceSecurityOptions, error) {
+	options := &instanceSecurityOptions{}
+	response, err := vpcRawGet(sess, `/instances/{id}`, map[string]string{"id": id}, options)
+	if err != nil {
+		return nil, fmt.Errorf("[ERROR] Error getting Instance (%s) security options: %s\n%s", id, err, response)
+	}
+	return options, nil
+}
+
+func setInstanceSecurityOptions(d *schema.ResourceData, options *instanceSecurityOptions) {
+	if options.ConfidentialComputeMode != nil {
+		d.Set(isInstanceConfidentialComputeMode, *options.ConfidentialComputeMode)
+	}
+	if options.EnableSecureBoot != nil {
+		d.Set(isInstanceEnableSecureBoot, *options.EnableSecureBoot)
+	}
+}
+
+type instanceProfileSecurityCapabilities struct {
+	Name                     *string `json:"name"`
+	ConfidentialComputeModes *struct {
+		Default *string  `json:"default"`
+		Type    *string  `json:"type"`
+		Values  []string `json:"values"`
+	} `json:"confidential_compute_modes"`
+	SecureBootModes *struct {
+		Default *bool   `json:"default"`
+		Type    *string `json:"type"`
+		Values  []bool  `json:"values"`
+	} `json:"secure_boot_modes"`
+}
+
+func getInstanceProfileSecurityCapabilities(sess *vpcv1.VpcV1, name string) (*instanceProfileSecurityCapabilities, error) {
+	capabilities := &instanceProfileSecurityCapabilities{}
+	response, err := vpcRawGet(sess, `/instance/profiles/{name}`, map[string]string{"name": name}, capabilities)
+	if err != nil {
+		return nil, fmt.Errorf("[ERROR] Error getting Instance Profile (%s) capabilities: %s\n%s", name, err, response)
+	}
+	return capabilities, nil
+}
+
+func listInstanceProfileSecurityCapabilities(sess *vpcv1.VpcV1) (map[string]*instanceProfileSecurityCapabilities, error) {
+	collection := struct {
+		Profiles []*instanceProfileSecurityCapabilities `json:"profiles"`
+	}{}
+	response, err := vpcRawGet(sess, `/instance/profiles`, nil, &collection)
+	if err != nil {
+		return nil, fmt.Errorf("[ERROR] Error listing Instance Profile capabilities: %s\n%s", err, response)
+	}
+	capabilities := make(map[string]*instanceProfileSecurityCapabilities, len(collection.Profiles))
+	for _, profile := range collection.Profiles {
+		if profile.Name != nil {
+			capabilities[*profile.Name] = profile
+		}
+	}
+	return capabilities, nil
+}
+
+func flattenInstanceProfileConfidentialComputeModes(capabilities *instanceProfileSecurityCapabilities) []map[string]interface{} {
+	modes := capabilities.ConfidentialComputeModes
+	if modes == nil {
+		return []map[string]interface{}{}
+	}
+	confidentialComputeMode := map[string]interface{}{
+		"values": modes.Values,
+	}
+	if modes.Default != nil {
+		confidentialComputeMode["default"] = *modes.Default
+	}
+	if modes.Type != nil {
+		confidentialComputeMode["type"] = *modes.Type
+	}
+	return []map[string]interface{}{confidentialComputeMode}
+}
+
+func flattenInstanceProfileSecureBootModes(capabilities *instanceProfileSecurityCapabilities) []map[string]interface{} {
+	modes := capabilities.SecureBootModes
+	if modes == nil {
+		return []map[string]interface{}{}
+	}
+	secureBootMode := map[string]interface{}{
+		"values": modes.Values,
+	}
+	if modes.Default != nil {
+		secureBootMode["default"] = *modes.Default
+	}
+	if modes.Type != nil {
+		secureBootMode["type"] = *modes.Type
+	}
+	return []map[string]interface{}{secureBootMode}
+}
+
+// ResourceValidateInstanceSecurityOptions checks at plan time that the profile supports the requested
+// confidential compute mode and secure boot setting.
+func ResourceValidateInstanceSecurityOptions(diff *schema.ResourceDiff, meta interface{}, profileKey string) error {
+	if !diff.HasChange(isInstanceConfidentialComputeMode) && !diff.HasChange(isInstanceEnableSecureBoot) && !diff.HasChange(profileKey) {
+		return nil
+	}
+	profile := diff.Get(profileKey).(string)
+	mode := diff.Get(isInstanceConfidentialComputeMode).(string)
+	secureBoot := diff.Get(isInstanceEnableSecureBoot).(bool)
+	if profile == "" || !diff.NewValueKnown(profileKey) || ((mode == "" || mode == "disabled") && !secureBoot) {
+		return nil
+	}
+	sess, err := vpcClient(meta)
+	if err != nil {
+		return err
+	}
+	capabilities, err := getInstanceProfileSecurityCapabilities(sess, profile)
+	if err != nil {
+		return err
+	}
+	if mode != "" && mode != "disabled" {
+		supported := capabilities.ConfidentialComputeModes != nil && flex.StringContains(capabilities.ConfidentialComputeModes.Values, mode)
+		if !supported {
+			return fmt.Errorf("[ERROR] Instance profile %s does not support %s %q", profile, isInstanceConfidentialComputeMode, mode)
+		}
+	}
+	if secureBoot {
+		supported := false
+		if capabilities.SecureBootModes != nil {
+			for _, value := range capabilities.SecureBootModes.Values {
+				supported = supported || value
+			}
+		}
+		if !supported {
+			return fmt.Errorf("[ERROR] Instance profile %s does not support %s", profile, isInstanceEnableSecureBoot)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,69 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package vpc
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/vpc-go-sdk/vpcv1"
+	"gotest.tools/assert"
+)
+
+// instanceProfilePayload is an instance profile as returned by GET /instance/profiles/{name}, trimmed to the
+// fields that are read with the raw request helper.
+const instanceProfilePayload = `{
+	"name": "bx3dc-2x10",
+	"href": "https://us-south.iaas.cloud.ibm.com/v1/instance/profiles/bx3dc-2x10",
+	"family": "balanced",
+	"confidential_compute_modes": {
+		"default": "disabled",
+		"type": "enum",
+		"values": ["disabled", "sgx", "tdx"]
+	},
+	"secure_boot_modes": {
+		"default": false,
+		"type": "enum",
+		"values": [false, true]
+	}
+}`
+
+func TestGetInstanceProfileSecurityCapabilities(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/instance/profiles/bx3dc-2x10":
+			w.Header().Set("Content-Type", "application/json")
+			w.Write([]byte(instanceProfilePayload))
+		case "/instance/profiles":
+			w.Header().Set("Content-Type", "application/json")
+			w.Write([]byte(`{"profiles": [` + instanceProfilePayload + `, {"name": "bx2-2x8"}]}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	sess, err := vpcv1.NewVpcV1(&vpcv1.VpcV1Options{
+		URL:           server.URL,
+		Authenticator: &core.NoAuthAuthenticator{},
+	})
+	assert.NilError(t, err)
+
+	capabilities, err := getInstanceProfileSecurityCapabilities(sess, "bx3dc-2x10")
+	assert.NilError(t, err)
+	assert.DeepEqual(t, flattenInstanceProfileConfidentialComputeModes(capabilities), []map[string]interface{}{
+		{"default": "disabled", "type": "enum", "values": []string{"disabled", "sgx", "tdx"}},
+	})
+	assert.DeepEqual(t, flattenInstanceProfileSecureBootModes(capabilities), []map[string]interface{}{
+		{"default": false, "type": "enum", "values": []bool{false, true}},
+	})
+
+	profiles, err := listInstanceProfileSecurityCapabilities(sess)
+	assert.NilError(t, err)
+	assert.Equal(t, len(profiles), 2)
+	assert.DeepEqual(t, profiles["bx3dc-2x10"].ConfidentialComputeModes.Values, []string{"disabled", "sgx", "tdx"})
+	assert.DeepEqual(t, flattenInstanceProfileConfidentialComputeModes(profiles["bx2-2x8"]), []map[string]interface{}{})
+}
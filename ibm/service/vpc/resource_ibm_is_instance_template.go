@@ -87,6 +87,10 @@ func ResourceIBMISInstanceTemplate() *schema.Resource {
 				func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
 					return flex.ResourceVolumeAttachmentValidate(diff)
 				}),
+			customdiff.Sequence(
+				func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
+					return ResourceValidateInstanceSecurityOptions(diff, v, isInstanceTemplateProfile)
+				}),
 		),
 
 		Schema: map[string]*schema.Schema{
@@ -173,6 +177,23 @@ func ResourceIBMISInstanceTemplate() *schema.Resource {
 				Description: "Profile info",
 			},
 
+			isInstanceConfidentialComputeMode: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.InvokeValidator("ibm_is_instance_template", isInstanceConfidentialComputeMode),
+				Description:  "The confidential compute mode to use for instances created from this template",
+			},
+
+			isInstanceEnableSecureBoot: {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: "Indicates whether secure boot is enabled for instances created from this template",
+			},
+
 			isInstanceDefaultTrustedProfileAutoLink: {
 				Type:         schema.TypeBool,
 				Optional:     true,
@@ -620,6 +641,13 @@ func ResourceIBMISInstanceTemplateValidator() *validate.ResourceValidator {
 			Regexp:                     `^[A-Za-z0-9:_ .-]+$`,
 			MinValueLength:             1,
 			MaxValueLength:             128})
+	validateSchema = append(validateSchema,
+		validate.ValidateSchema{
+			Identifier:                 isInstanceConfidentialComputeMode,
+			ValidateFunctionIdentifier: validate.ValidateAllowedStringValue,
+			Type:                       validate.TypeString,
+			Optional:                   true,
+			AllowedValues:              "disabled, sgx, tdx"})
 	ibmISInstanceTemplateValidator := validate.ResourceValidator{ResourceName: "ibm_is_instance_template", Schema: validateSchema}
 	return &ibmISInstanceTemplateValidator
 }
@@ -1113,7 +1141,7 @@ func instanceTemplateCreateByCatalogOffering(d *schema.ResourceData, meta interf
 	}
 
 	options := &vpcv1.CreateInstanceTemplateOptions{
-		InstanceTemplatePrototype: instanceproto,
+		InstanceTemplatePrototype: instanceTemplatePrototypeWithSecurity(d, instanceproto),
 	}
 
 	instanceIntf, response, err := sess.CreateInstanceTemplate(options)
@@ -1536,7 +1564,7 @@ func instanceTemplateCreate(d *schema.ResourceData, meta interface{}, profile, n
 	}
 
 	options := &vpcv1.CreateInstanceTemplateOptions{
-		InstanceTemplatePrototype: instanceproto,
+		InstanceTemplatePrototype: instanceTemplatePrototypeWithSecurity(d, instanceproto),
 	}
 
 	instanceIntf, response, err := sess.CreateInstanceTemplate(options)
@@ -1592,6 +1620,12 @@ func instanceTemplateGet(d *schema.ResourceData, meta interface{}, ID string) er
 		identity := instanceProfileIntf.(*vpcv1.InstanceProfileIdentity)
 		d.Set(isInstanceTemplateProfile, *identity.Name)
 	}
+	securityOptions := &instanceSecurityOptions{}
+	response, err = vpcRawGet(instanceC, `/instance/templates/{id}`, map[string]string{"id": ID}, securityOptions)
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error Getting Instance template (%s) security options: %s\n%s", ID, err, response)
+	}
+	setInstanceSecurityOptions(d, securityOptions)
 
 	if instance.DefaultTrustedProfile != nil {
 		if instance.DefaultTrustedProfile.AutoLink != nil {
@@ -25,6 +25,10 @@ const (
 	isLBPoolMemberWeight             = "weight"
 	isLBPoolMemberProvisioningStatus = "provisioning_status"
 	isLBPoolMemberHealth             = "health"
+	isLBPoolMemberWaitForHealthy     = "wait_for_healthy"
+	isLBPoolMemberHealthOk           = "ok"
+	isLBPoolMemberHealthFaulted      = "faulted"
+	isLBPoolMemberHealthUnknown      = "unknown"
 	isLBPoolMemberHref               = "href"
 	isLBPoolMemberDeletePending      = "delete_pending"
 	isLBPoolMemberDeleted            = "done"
@@ -110,6 +114,13 @@ func ResourceIBMISLBPoolMember() *schema.Resource {
 				Description:  "Load balcner pool member weight",
 			},
 
+			isLBPoolMemberWaitForHealthy: {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Wait on creation until the health of the member is ok. The wait is bounded by the create timeout",
+			},
+
 			isLBPoolMemberProvisioningStatus: {
 				Type:        schema.TypeString,
 				Computed:    true,
@@ -169,13 +180,28 @@ func resourceIBMISLBPoolMemberCreate(d *schema.ResourceData, meta interface{}) e
 
 	isLBKey := "load_balancer_key_" + lbID
 	conns.IbmMutexKV.Lock(isLBKey)
-	defer conns.IbmMutexKV.Unlock(isLBKey)
-
 	err = lbpMemberCreate(d, meta, lbID, lbPoolID, port64, weight)
+	conns.IbmMutexKV.Unlock(isLBKey)
 	if err != nil {
 		return err
 	}
 
+	// the health check does not change the load balancer, so other members can be created while waiting
+	if d.Get(isLBPoolMemberWaitForHealthy).(bool) {
+		sess, err := vpcClient(meta)
+		if err != nil {
+			return err
+		}
+		parts, err := flex.IdParts(d.Id())
+		if err != nil {
+			return err
+		}
+		_, err = isWaitForLBPoolMemberHealthy(sess, lbID, lbPoolID, parts[2], d.Timeout(schema.TimeoutCreate))
+		if err != nil {
+			return err
+		}
+	}
+
 	return resourceIBMISLBPoolMemberRead(d, meta)
 }
 
@@ -259,6 +285,38 @@ func isWaitForLBPoolMemberAvailable(lbc *vpcv1.VpcV1, lbID, lbPoolID, lbPoolMemI
 	return stateConf.WaitForState()
 }
 
+func isWaitForLBPoolMemberHealthy(lbc *vpcv1.VpcV1, lbID, lbPoolID, lbPoolMemID string, timeout time.Duration) (interface{}, error) {
+	log.Printf("Waiting for load balancer pool member(%s) to be healthy.", lbPoolMemID)
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{isLBPoolMemberHealthUnknown, isLBPoolMemberHealthFaulted},
+		Target:     []string{isLBPoolMemberHealthOk},
+		Refresh:    isLBPoolMemberHealthRefreshFunc(lbc, lbID, lbPoolID, lbPoolMemID),
+		Timeout:    timeout,
+		Delay:      10 * time.Second,
+		MinTimeout: 10 * time.Second,
+	}
+
+	return stateConf.WaitForState()
+}
+
+func isLBPoolMemberHealthRefreshFunc(lbc *vpcv1.VpcV1, lbID, lbPoolID, lbPoolMemID string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+
+		getlbpmoptions := &vpcv1.GetLoadBalancerPoolMemberOptions{
+			LoadBalancerID: &lbID,
+			PoolID:         &lbPoolID,
+			ID:             &lbPoolMemID,
+		}
+		lbPoolMem, response, err := lbc.GetLoadBalancerPoolMember(getlbpmoptions)
+		if err != nil {
+			return nil, "", fmt.Errorf("[ERROR] Error Getting Load Balancer Pool Member: %s\n%s", err, response)
+		}
+
+		return lbPoolMem, *lbPoolMem.Health, nil
+	}
+}
+
 func isLBPoolMemberRefreshFunc(lbc *vpcv1.VpcV1, lbID, lbPoolID, lbPoolMemID string) resource.StateRefreshFunc {
 	return func() (interface{}, string, error) {
 
@@ -337,6 +395,9 @@ func lbpmemberGet(d *schema.ResourceData, meta interface{}, lbID, lbPoolID, lbPo
 	d.Set(isLBPoolMemberProvisioningStatus, *lbPoolMem.ProvisioningStatus)
 	d.Set(isLBPoolMemberHealth, *lbPoolMem.Health)
 	d.Set(isLBPoolMemberHref, *lbPoolMem.Href)
+	if _, ok := d.GetOkExists(isLBPoolMemberWaitForHealthy); !ok {
+		d.Set(isLBPoolMemberWaitForHealthy, false)
+	}
 	getLoadBalancerOptions := &vpcv1.GetLoadBalancerOptions{
 		ID: &lbID,
 	}
@@ -46,6 +46,7 @@ const (
 	isNetworkACLTags              = "tags"
 	isNetworkACLAccessTags        = "access_tags"
 	isNetworkACLCRN               = "crn"
+	isNetworkACLManagedRuleNames  = "managed_rule_names"
 )
 
 func ResourceIBMISNetworkACL() *schema.Resource {
@@ -72,6 +73,10 @@ func ResourceIBMISNetworkACL() *schema.Resource {
 				func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
 					return flex.ResourceValidateAccessTags(diff, v)
 				}),
+			customdiff.Sequence(
+				func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
+					return resourceIBMISNetworkACLInlineRulesCustomizeDiff(diff)
+				}),
 		),
 
 		Schema: map[string]*schema.Schema{
@@ -140,6 +145,12 @@ func ResourceIBMISNetworkACL() *schema.Resource {
 				Computed:    true,
 				Description: "The resource group name in which resource is provisioned",
 			},
+			isNetworkACLManagedRuleNames: {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The names of the network ACL rules that are managed by the rules block",
+			},
 			isNetworkACLRules: {
 				Type:     schema.TypeList,
 				Optional: true,
@@ -471,6 +482,7 @@ func nwaclCreate(d *schema.ResourceData, meta interface{}, name string) error {
 	if err != nil {
 		return err
 	}
+	d.Set(isNetworkACLManagedRuleNames, networkACLInlineRuleNames(rules))
 	v := os.Getenv("IC_ENV_TAGS")
 	if _, ok := d.GetOk(isNetworkACLTags); ok || v != "" {
 		oldList, newList := d.GetChange(isNetworkACLTags)
@@ -538,86 +550,15 @@ func nwaclGet(d *schema.ResourceData, meta interface{}, id string) error {
 	d.Set(isNetworkACLAccessTags, accesstags)
 	d.Set(isNetworkACLCRN, *nwacl.CRN)
 	rules := make([]interface{}, 0)
-	if len(nwacl.Rules) > 0 {
-		for _, rulex := range nwacl.Rules {
-			log.Println("[DEBUG] Type of the Rule", reflect.TypeOf(rulex))
-			rule := make(map[string]interface{})
-			rule[isNetworkACLSubnets] = len(nwacl.Subnets)
-			switch reflect.TypeOf(rulex).String() {
-			case "*vpcv1.NetworkACLRuleItemNetworkACLRuleProtocolIcmp":
-				{
-					rulex := rulex.(*vpcv1.NetworkACLRuleItemNetworkACLRuleProtocolIcmp)
-					rule[isNetworkACLRuleID] = *rulex.ID
-					rule[isNetworkACLRuleName] = *rulex.Name
-					rule[isNetworkACLRuleAction] = *rulex.Action
-					rule[isNetworkACLRuleIPVersion] = *rulex.IPVersion
-					rule[isNetworkACLRuleSource] = *rulex.Source
-					rule[isNetworkACLRuleDestination] = *rulex.Destination
-					rule[isNetworkACLRuleDirection] = *rulex.Direction
-					rule[isNetworkACLRuleTCP] = make([]map[string]int, 0, 0)
-					rule[isNetworkACLRuleUDP] = make([]map[string]int, 0, 0)
-					icmp := make([]map[string]int, 1, 1)
-					if rulex.Code != nil && rulex.Type != nil {
-						icmp[0] = map[string]int{
-							isNetworkACLRuleICMPCode: int(*rulex.Code),
-							isNetworkACLRuleICMPType: int(*rulex.Type),
-						}
-					}
-					rule[isNetworkACLRuleICMP] = icmp
-				}
-			case "*vpcv1.NetworkACLRuleItemNetworkACLRuleProtocolTcpudp":
-				{
-					rulex := rulex.(*vpcv1.NetworkACLRuleItemNetworkACLRuleProtocolTcpudp)
-					rule[isNetworkACLRuleID] = *rulex.ID
-					rule[isNetworkACLRuleName] = *rulex.Name
-					rule[isNetworkACLRuleAction] = *rulex.Action
-					rule[isNetworkACLRuleIPVersion] = *rulex.IPVersion
-					rule[isNetworkACLRuleSource] = *rulex.Source
-					rule[isNetworkACLRuleDestination] = *rulex.Destination
-					rule[isNetworkACLRuleDirection] = *rulex.Direction
-					if *rulex.Protocol == "tcp" {
-						rule[isNetworkACLRuleICMP] = make([]map[string]int, 0, 0)
-						rule[isNetworkACLRuleUDP] = make([]map[string]int, 0, 0)
-						tcp := make([]map[string]int, 1, 1)
-						tcp[0] = map[string]int{
-							isNetworkACLRuleSourcePortMax: checkNetworkACLNil(rulex.SourcePortMax),
-							isNetworkACLRuleSourcePortMin: checkNetworkACLNil(rulex.SourcePortMin),
-						}
-						tcp[0][isNetworkACLRulePortMax] = checkNetworkACLNil(rulex.DestinationPortMax)
-						tcp[0][isNetworkACLRulePortMin] = checkNetworkACLNil(rulex.DestinationPortMin)
-						rule[isNetworkACLRuleTCP] = tcp
-					} else if *rulex.Protocol == "udp" {
-						rule[isNetworkACLRuleICMP] = make([]map[string]int, 0, 0)
-						rule[isNetworkACLRuleTCP] = make([]map[string]int, 0, 0)
-						udp := make([]map[string]int, 1, 1)
-						udp[0] = map[string]int{
-							isNetworkACLRuleSourcePortMax: checkNetworkACLNil(rulex.SourcePortMax),
-							isNetworkACLRuleSourcePortMin: checkNetworkACLNil(rulex.SourcePortMin),
-						}
-						udp[0][isNetworkACLRulePortMax] = checkNetworkACLNil(rulex.DestinationPortMax)
-						udp[0][isNetworkACLRulePortMin] = checkNetworkACLNil(rulex.DestinationPortMin)
-						rule[isNetworkACLRuleUDP] = udp
-					}
-				}
-			case "*vpcv1.NetworkACLRuleItemNetworkACLRuleProtocolAll":
-				{
-					rulex := rulex.(*vpcv1.NetworkACLRuleItemNetworkACLRuleProtocolAll)
-					rule[isNetworkACLRuleID] = *rulex.ID
-					rule[isNetworkACLRuleName] = *rulex.Name
-					rule[isNetworkACLRuleAction] = *rulex.Action
-					rule[isNetworkACLRuleIPVersion] = *rulex.IPVersion
-					rule[isNetworkACLRuleSource] = *rulex.Source
-					rule[isNetworkACLRuleDestination] = *rulex.Destination
-					rule[isNetworkACLRuleDirection] = *rulex.Direction
-					rule[isNetworkACLRuleICMP] = make([]map[string]int, 0, 0)
-					rule[isNetworkACLRuleTCP] = make([]map[string]int, 0, 0)
-					rule[isNetworkACLRuleUDP] = make([]map[string]int, 0, 0)
-				}
-			}
-			rules = append(rules, rule)
-		}
+	for _, rulex := range nwacl.Rules {
+		log.Println("[DEBUG] Type of the Rule", reflect.TypeOf(rulex))
+		rules = append(rules, networkACLRuleToMap(rulex, len(nwacl.Subnets)))
 	}
 	d.Set(isNetworkACLRules, rules)
+	if _, ok := d.GetOk(isNetworkACLManagedRuleNames); !ok {
+		// Network ACLs that were imported or created before the rules block recorded its rules adopt the rules they have
+		d.Set(isNetworkACLManagedRuleNames, networkACLInlineRuleNames(rules))
+	}
 	controller, err := flex.GetBaseController(meta)
 	if err != nil {
 		return err
@@ -690,17 +631,15 @@ func nwaclUpdate(d *schema.ResourceData, meta interface{}, id, name string, hasC
 		if err != nil {
 			return err
 		}
-		//Delete all existing rules
-		err = clearRules(sess, id)
-		if err != nil {
-			return err
-		}
-		//Create the rules as per the def
-		err = createInlineRules(sess, id, rules)
+		oldManaged, _ := d.GetChange(isNetworkACLManagedRuleNames)
+		err = reconcileNetworkACLInlineRules(sess, id, flex.ExpandStringList(oldManaged.([]interface{})), rules)
 		if err != nil {
 			return err
 		}
 	}
+	if _, ok := d.GetOk(isNetworkACLRules); ok {
+		d.Set(isNetworkACLManagedRuleNames, networkACLInlineRuleNames(rules))
+	}
 	return nil
 }
 
@@ -769,6 +708,84 @@ func nwaclExists(d *schema.ResourceData, meta interface{}, id string) (bool, err
 	return true, nil
 }
 
+// networkACLRuleToMap converts a rule of the network ACL to the representation of the rules attribute.
+func networkACLRuleToMap(rulex vpcv1.NetworkACLRuleItemIntf, subnets int) map[string]interface{} {
+	rule := make(map[string]interface{})
+	rule[isNetworkACLSubnets] = subnets
+	switch reflect.TypeOf(rulex).String() {
+	case "*vpcv1.NetworkACLRuleItemNetworkACLRuleProtocolIcmp":
+		{
+			rulex := rulex.(*vpcv1.NetworkACLRuleItemNetworkACLRuleProtocolIcmp)
+			rule[isNetworkACLRuleID] = *rulex.ID
+			rule[isNetworkACLRuleName] = *rulex.Name
+			rule[isNetworkACLRuleAction] = *rulex.Action
+			rule[isNetworkACLRuleIPVersion] = *rulex.IPVersion
+			rule[isNetworkACLRuleSource] = *rulex.Source
+			rule[isNetworkACLRuleDestination] = *rulex.Destination
+			rule[isNetworkACLRuleDirection] = *rulex.Direction
+			rule[isNetworkACLRuleTCP] = make([]map[string]int, 0, 0)
+			rule[isNetworkACLRuleUDP] = make([]map[string]int, 0, 0)
+			icmp := make([]map[string]int, 1, 1)
+			if rulex.Code != nil && rulex.Type != nil {
+				icmp[0] = map[string]int{
+					isNetworkACLRuleICMPCode: int(*rulex.Code),
+					isNetworkACLRuleICMPType: int(*rulex.Type),
+				}
+			}
+			rule[isNetworkACLRuleICMP] = icmp
+		}
+	case "*vpcv1.NetworkACLRuleItemNetworkACLRuleProtocolTcpudp":
+		{
+			rulex := rulex.(*vpcv1.NetworkACLRuleItemNetworkACLRuleProtocolTcpudp)
+			rule[isNetworkACLRuleID] = *rulex.ID
+			rule[isNetworkACLRuleName] = *rulex.Name
+			rule[isNetworkACLRuleAction] = *rulex.Action
+			rule[isNetworkACLRuleIPVersion] = *rulex.IPVersion
+			rule[isNetworkACLRuleSource] = *rulex.Source
+			rule[isNetworkACLRuleDestination] = *rulex.Destination
+			rule[isNetworkACLRuleDirection] = *rulex.Direction
+			if *rulex.Protocol == "tcp" {
+				rule[isNetworkACLRuleICMP] = make([]map[string]int, 0, 0)
+				rule[isNetworkACLRuleUDP] = make([]map[string]int, 0, 0)
+				tcp := make([]map[string]int, 1, 1)
+				tcp[0] = map[string]int{
+					isNetworkACLRuleSourcePortMax: checkNetworkACLNil(rulex.SourcePortMax),
+					isNetworkACLRuleSourcePortMin: checkNetworkACLNil(rulex.SourcePortMin),
+				}
+				tcp[0][isNetworkACLRulePortMax] = checkNetworkACLNil(rulex.DestinationPortMax)
+				tcp[0][isNetworkACLRulePortMin] = checkNetworkACLNil(rulex.DestinationPortMin)
+				rule[isNetworkACLRuleTCP] = tcp
+			} else if *rulex.Protocol == "udp" {
+				rule[isNetworkACLRuleICMP] = make([]map[string]int, 0, 0)
+				rule[isNetworkACLRuleTCP] = make([]map[string]int, 0, 0)
+				udp := make([]map[string]int, 1, 1)
+				udp[0] = map[string]int{
+					isNetworkACLRuleSourcePortMax: checkNetworkACLNil(rulex.SourcePortMax),
+					isNetworkACLRuleSourcePortMin: checkNetworkACLNil(rulex.SourcePortMin),
+				}
+				udp[0][isNetworkACLRulePortMax] = checkNetworkACLNil(rulex.DestinationPortMax)
+				udp[0][isNetworkACLRulePortMin] = checkNetworkACLNil(rulex.DestinationPortMin)
+				rule[isNetworkACLRuleUDP] = udp
+			}
+		}
+	case "*vpcv1.NetworkACLRuleItemNetworkACLRuleProtocolAll":
+		{
+			rulex := rulex.(*vpcv1.NetworkACLRuleItemNetworkACLRuleProtocolAll)
+			rule[isNetworkACLRuleID] = *rulex.ID
+			rule[isNetworkACLRuleName] = *rulex.Name
+			rule[isNetworkACLRuleAction] = *rulex.Action
+			rule[isNetworkACLRuleIPVersion] = *rulex.IPVersion
+			rule[isNetworkACLRuleSource] = *rulex.Source
+			rule[isNetworkACLRuleDestination] = *rulex.Destination
+			rule[isNetworkACLRuleDirection] = *rulex.Direction
+			rule[isNetworkACLRuleICMP] = make([]map[string]int, 0, 0)
+			rule[isNetworkACLRuleTCP] = make([]map[string]int, 0, 0)
+			rule[isNetworkACLRuleUDP] = make([]map[string]int, 0, 0)
+		}
+	}
+	return rule
+}
+
 func checkNetworkACLNil(ptr *int64) int {
 	if ptr == nil {
 		return 0
@@ -844,111 +861,319 @@ func validateInlineRules(rules []interface{}) error {
 	return nil
 }
 
-func createInlineRules(nwaclC *vpcv1.VpcV1, nwaclid string, rules []interface{}) error {
-	before := ""
+// resourceIBMISNetworkACLInlineRulesCustomizeDiff records the names of the rules declared in the rules block, and
+// fails the plan when the network ACL has rules that are neither declared nor were created by the rules block, such
+// as the rules of ibm_is_network_acl_rule resources.
+func resourceIBMISNetworkACLInlineRulesCustomizeDiff(diff *schema.ResourceDiff) error {
+	raw := diff.GetRawConfig().GetAttr(isNetworkACLRules)
+	if raw.IsNull() || !raw.IsKnown() || raw.LengthInt() == 0 {
+		return nil
+	}
+	declared := []string{}
+	for it := raw.ElementIterator(); it.Next(); {
+		_, rule := it.Element()
+		name := rule.GetAttr(isNetworkACLRuleName)
+		if !name.IsKnown() {
+			return diff.SetNewComputed(isNetworkACLManagedRuleNames)
+		}
+		if !name.IsNull() {
+			declared = append(declared, name.AsString())
+		}
+	}
+	if diff.Id() != "" {
+		oldManaged, _ := diff.GetChange(isNetworkACLManagedRuleNames)
+		oldRules, _ := diff.GetChange(isNetworkACLRules)
+		actual := []string{}
+		for _, rule := range oldRules.([]interface{}) {
+			actual = append(actual, rule.(map[string]interface{})[isNetworkACLRuleName].(string))
+		}
+		if unmanaged := unmanagedNetworkACLRules(actual, flex.ExpandStringList(oldManaged.([]interface{})), declared); len(unmanaged) > 0 {
+			return networkACLUnmanagedRulesError(diff.Id(), unmanaged)
+		}
+	}
+	return diff.SetNew(isNetworkACLManagedRuleNames, declared)
+}
 
-	for i := 0; i <= len(rules)-1; i++ {
-		rulex := rules[i].(map[string]interface{})
+// unmanagedNetworkACLRules returns the names of the rules of the network ACL that are neither declared in the rules
+// block nor were managed by it before.
+func unmanagedNetworkACLRules(actual, managed, declared []string) []string {
+	known := make(map[string]bool, len(managed)+len(declared))
+	for _, name := range managed {
+		known[name] = true
+	}
+	for _, name := range declared {
+		known[name] = true
+	}
+	unmanaged := []string{}
+	for _, name := range actual {
+		if !known[name] {
+			unmanaged = append(unmanaged, name)
+		}
+	}
+	return unmanaged
+}
 
-		name := rulex[isNetworkACLRuleName].(string)
-		source := rulex[isNetworkACLRuleSource].(string)
-		destination := rulex[isNetworkACLRuleDestination].(string)
-		action := rulex[isNetworkACLRuleAction].(string)
-		direction := rulex[isNetworkACLRuleDirection].(string)
-		icmp := rulex[isNetworkACLRuleICMP].([]interface{})
-		tcp := rulex[isNetworkACLRuleTCP].([]interface{})
-		udp := rulex[isNetworkACLRuleUDP].([]interface{})
-		icmptype := int64(-1)
-		icmpcode := int64(-1)
-		minport := int64(-1)
-		maxport := int64(-1)
-		sourceminport := int64(-1)
-		sourcemaxport := int64(-1)
-		protocol := "all"
-
-		ruleTemplate := &vpcv1.NetworkACLRulePrototype{
-			Action:      &action,
-			Destination: &destination,
-			Direction:   &direction,
-			Source:      &source,
-			Name:        &name,
-		}
-
-		if before != "" {
-			ruleTemplate.Before = &vpcv1.NetworkACLRuleBeforePrototype{
-				ID: &before,
-			}
+func networkACLUnmanagedRulesError(nwaclid string, unmanaged []string) error {
+	return fmt.Errorf("[ERROR] Network ACL (%s) has rules that are not declared in the %s block: %s. "+
+		"Rules managed by ibm_is_network_acl_rule resources can't be combined with the %s block on the same network ACL. "+
+		"Declare the rules in the %s block, or delete them from the network ACL", nwaclid, isNetworkACLRules, strings.Join(unmanaged, ", "), isNetworkACLRules, isNetworkACLRules)
+}
+
+func networkACLInlineRuleNames(rules []interface{}) []string {
+	names := make([]string, 0, len(rules))
+	for _, rule := range rules {
+		names = append(names, rule.(map[string]interface{})[isNetworkACLRuleName].(string))
+	}
+	return names
+}
+
+// reconcileNetworkACLInlineRules makes the rules of the network ACL match the declared rules. Rules that are
+// unchanged and already in the declared order are kept, the other managed rules are deleted, and the missing rules
+// are created in front of the rule that follows them in the declared order.
+func reconcileNetworkACLInlineRules(nwaclC *vpcv1.VpcV1, nwaclid string, managed []string, rules []interface{}) error {
+	start := ""
+	allrecs := []vpcv1.NetworkACLRuleItemIntf{}
+	for {
+		listNetworkACLRulesOptions := &vpcv1.ListNetworkACLRulesOptions{
+			NetworkACLID: &nwaclid,
 		}
+		if start != "" {
+			listNetworkACLRulesOptions.Start = &start
+		}
+		rawrules, response, err := nwaclC.ListNetworkACLRules(listNetworkACLRulesOptions)
+		if err != nil {
+			return fmt.Errorf("[ERROR] Error Listing network ACL rules : %s\n%s", err, response)
+		}
+		start = flex.GetNext(rawrules.Next)
+		allrecs = append(allrecs, rawrules.Rules...)
+		if start == "" {
+			break
+		}
+	}
 
-		if len(icmp) > 0 {
-			protocol = "icmp"
-			ruleTemplate.Protocol = &protocol
-			if !isNil(icmp[0]) {
-				icmpval := icmp[0].(map[string]interface{})
-				if val, ok := icmpval[isNetworkACLRuleICMPType]; ok {
-					icmptype = int64(val.(int))
-					ruleTemplate.Type = &icmptype
-				}
-				if val, ok := icmpval[isNetworkACLRuleICMPCode]; ok {
-					icmpcode = int64(val.(int))
-					ruleTemplate.Code = &icmpcode
-				}
-			}
-		} else if len(tcp) > 0 {
-			protocol = "tcp"
-			ruleTemplate.Protocol = &protocol
-			tcpval := tcp[0].(map[string]interface{})
-			if val, ok := tcpval[isNetworkACLRulePortMin]; ok {
-				minport = int64(val.(int))
-				ruleTemplate.DestinationPortMin = &minport
-			}
-			if val, ok := tcpval[isNetworkACLRulePortMax]; ok {
-				maxport = int64(val.(int))
-				ruleTemplate.DestinationPortMax = &maxport
-			}
-			if val, ok := tcpval[isNetworkACLRuleSourcePortMin]; ok {
-				sourceminport = int64(val.(int))
-				ruleTemplate.SourcePortMin = &sourceminport
+	actual := make([]map[string]interface{}, 0, len(allrecs))
+	names := make([]string, 0, len(allrecs))
+	for _, rulex := range allrecs {
+		rule := networkACLRuleToMap(rulex, 0)
+		actual = append(actual, rule)
+		names = append(names, rule[isNetworkACLRuleName].(string))
+	}
+	if unmanaged := unmanagedNetworkACLRules(names, managed, networkACLInlineRuleNames(rules)); len(unmanaged) > 0 {
+		return networkACLUnmanagedRulesError(nwaclid, unmanaged)
+	}
+
+	kept := planNetworkACLInlineRules(actual, rules)
+	keptIDs := make(map[string]bool, len(kept))
+	for _, id := range kept {
+		if id != "" {
+			keptIDs[id] = true
+		}
+	}
+	for _, rule := range actual {
+		id := rule[isNetworkACLRuleID].(string)
+		if keptIDs[id] {
+			continue
+		}
+		deleteNetworkAclRuleOptions := &vpcv1.DeleteNetworkACLRuleOptions{
+			NetworkACLID: &nwaclid,
+			ID:           &id,
+		}
+		response, err := nwaclC.DeleteNetworkACLRule(deleteNetworkAclRuleOptions)
+		if err != nil {
+			return fmt.Errorf("[ERROR] Error Deleting network ACL rule : %s\n%s", err, response)
+		}
+	}
+
+	before := ""
+	for i := len(rules) - 1; i >= 0; i-- {
+		if kept[i] != "" {
+			before = kept[i]
+			continue
+		}
+		id, err := createInlineRule(nwaclC, nwaclid, rules[i].(map[string]interface{}), before)
+		if err != nil {
+			return err
+		}
+		before = id
+	}
+	return nil
+}
+
+// planNetworkACLInlineRules returns, for each declared rule, the ID of the rule of the network ACL that is kept for
+// it, or an empty string when the rule must be created. A rule is kept when it is unchanged and it follows the
+// previously kept rule, so that the kept rules are already in the declared order.
+func planNetworkACLInlineRules(actual []map[string]interface{}, rules []interface{}) []string {
+	kept := make([]string, len(rules))
+	next := 0
+	for _, rule := range actual {
+		for i := next; i < len(rules); i++ {
+			declared := rules[i].(map[string]interface{})
+			if declared[isNetworkACLRuleName] != rule[isNetworkACLRuleName] {
+				continue
 			}
-			if val, ok := tcpval[isNetworkACLRuleSourcePortMax]; ok {
-				sourcemaxport = int64(val.(int))
-				ruleTemplate.SourcePortMax = &sourcemaxport
+			if networkACLInlineRuleMatches(rule, declared) {
+				kept[i] = rule[isNetworkACLRuleID].(string)
+				next = i + 1
 			}
-		} else if len(udp) > 0 {
-			protocol = "udp"
-			ruleTemplate.Protocol = &protocol
-			udpval := udp[0].(map[string]interface{})
-			if val, ok := udpval[isNetworkACLRulePortMin]; ok {
-				minport = int64(val.(int))
-				ruleTemplate.DestinationPortMin = &minport
+			break
+		}
+	}
+	return kept
+}
+
+// networkACLInlineRuleMatches reports whether a rule of the network ACL, as converted by networkACLRuleToMap, has
+// the same settings as a declared rule.
+func networkACLInlineRuleMatches(rule, declared map[string]interface{}) bool {
+	for _, key := range []string{isNetworkACLRuleAction, isNetworkACLRuleSource, isNetworkACLRuleDestination, isNetworkACLRuleDirection} {
+		if rule[key] != declared[key] {
+			return false
+		}
+	}
+	for _, key := range []string{isNetworkACLRuleICMP, isNetworkACLRuleTCP, isNetworkACLRuleUDP} {
+		actual, _ := rule[key].([]map[string]int)
+		expected, _ := declared[key].([]interface{})
+		if len(actual) != len(expected) {
+			return false
+		}
+		if len(expected) == 0 {
+			continue
+		}
+		values, _ := expected[0].(map[string]interface{})
+		if len(values) == 0 {
+			if len(actual[0]) != 0 {
+				return false
 			}
-			if val, ok := udpval[isNetworkACLRulePortMax]; ok {
-				maxport = int64(val.(int))
-				ruleTemplate.DestinationPortMax = &maxport
+			continue
+		}
+		for name, value := range values {
+			if actual[0][name] != value.(int) {
+				return false
 			}
-			if val, ok := udpval[isNetworkACLRuleSourcePortMin]; ok {
-				sourceminport = int64(val.(int))
-				ruleTemplate.SourcePortMin = &sourceminport
+		}
+	}
+	return true
+}
+
+func createInlineRules(nwaclC *vpcv1.VpcV1, nwaclid string, rules []interface{}) error {
+	for i := 0; i <= len(rules)-1; i++ {
+		_, err := createInlineRule(nwaclC, nwaclid, rules[i].(map[string]interface{}), "")
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// createInlineRule creates a declared rule in front of the rule with the ID before, or after all rules when before
+// is empty, and returns the ID of the new rule.
+func createInlineRule(nwaclC *vpcv1.VpcV1, nwaclid string, rulex map[string]interface{}, before string) (string, error) {
+
+	name := rulex[isNetworkACLRuleName].(string)
+	source := rulex[isNetworkACLRuleSource].(string)
+	destination := rulex[isNetworkACLRuleDestination].(string)
+	action := rulex[isNetworkACLRuleAction].(string)
+	direction := rulex[isNetworkACLRuleDirection].(string)
+	icmp := rulex[isNetworkACLRuleICMP].([]interface{})
+	tcp := rulex[isNetworkACLRuleTCP].([]interface{})
+	udp := rulex[isNetworkACLRuleUDP].([]interface{})
+	icmptype := int64(-1)
+	icmpcode := int64(-1)
+	minport := int64(-1)
+	maxport := int64(-1)
+	sourceminport := int64(-1)
+	sourcemaxport := int64(-1)
+	protocol := "all"
+
+	ruleTemplate := &vpcv1.NetworkACLRulePrototype{
+		Action:      &action,
+		Destination: &destination,
+		Direction:   &direction,
+		Source:      &source,
+		Name:        &name,
+	}
+
+	if before != "" {
+		ruleTemplate.Before = &vpcv1.NetworkACLRuleBeforePrototype{
+			ID: &before,
+		}
+	}
+
+	if len(icmp) > 0 {
+		protocol = "icmp"
+		ruleTemplate.Protocol = &protocol
+		if !isNil(icmp[0]) {
+			icmpval := icmp[0].(map[string]interface{})
+			if val, ok := icmpval[isNetworkACLRuleICMPType]; ok {
+				icmptype = int64(val.(int))
+				ruleTemplate.Type = &icmptype
 			}
-			if val, ok := udpval[isNetworkACLRuleSourcePortMax]; ok {
-				sourcemaxport = int64(val.(int))
-				ruleTemplate.SourcePortMax = &sourcemaxport
+			if val, ok := icmpval[isNetworkACLRuleICMPCode]; ok {
+				icmpcode = int64(val.(int))
+				ruleTemplate.Code = &icmpcode
 			}
 		}
-		if protocol == "all" {
-			ruleTemplate.Protocol = &protocol
+	} else if len(tcp) > 0 {
+		protocol = "tcp"
+		ruleTemplate.Protocol = &protocol
+		tcpval := tcp[0].(map[string]interface{})
+		if val, ok := tcpval[isNetworkACLRulePortMin]; ok {
+			minport = int64(val.(int))
+			ruleTemplate.DestinationPortMin = &minport
 		}
-
-		createNetworkAclRuleOptions := &vpcv1.CreateNetworkACLRuleOptions{
-			NetworkACLID:            &nwaclid,
-			NetworkACLRulePrototype: ruleTemplate,
+		if val, ok := tcpval[isNetworkACLRulePortMax]; ok {
+			maxport = int64(val.(int))
+			ruleTemplate.DestinationPortMax = &maxport
 		}
-		_, response, err := nwaclC.CreateNetworkACLRule(createNetworkAclRuleOptions)
-		if err != nil {
-			return fmt.Errorf("[ERROR] Error Creating network ACL rule : %s\n%s", err, response)
+		if val, ok := tcpval[isNetworkACLRuleSourcePortMin]; ok {
+			sourceminport = int64(val.(int))
+			ruleTemplate.SourcePortMin = &sourceminport
+		}
+		if val, ok := tcpval[isNetworkACLRuleSourcePortMax]; ok {
+			sourcemaxport = int64(val.(int))
+			ruleTemplate.SourcePortMax = &sourcemaxport
+		}
+	} else if len(udp) > 0 {
+		protocol = "udp"
+		ruleTemplate.Protocol = &protocol
+		udpval := udp[0].(map[string]interface{})
+		if val, ok := udpval[isNetworkACLRulePortMin]; ok {
+			minport = int64(val.(int))
+			ruleTemplate.DestinationPortMin = &minport
+		}
+		if val, ok := udpval[isNetworkACLRulePortMax]; ok {
+			maxport = int64(val.(int))
+			ruleTemplate.DestinationPortMax = &maxport
+		}
+		if val, ok := udpval[isNetworkACLRuleSourcePortMin]; ok {
+			sourceminport = int64(val.(int))
+			ruleTemplate.SourcePortMin = &sourceminport
+		}
+		if val, ok := udpval[isNetworkACLRuleSourcePortMax]; ok {
+			sourcemaxport = int64(val.(int))
+			ruleTemplate.SourcePortMax = &sourcemaxport
 		}
 	}
-	return nil
+	if protocol == "all" {
+		ruleTemplate.Protocol = &protocol
+	}
+
+	createNetworkAclRuleOptions := &vpcv1.CreateNetworkACLRuleOptions{
+		NetworkACLID:            &nwaclid,
+		NetworkACLRulePrototype: ruleTemplate,
+	}
+	rule, response, err := nwaclC.CreateNetworkACLRule(createNetworkAclRuleOptions)
+	if err != nil {
+		return "", fmt.Errorf("[ERROR] Error Creating network ACL rule : %s\n%s", err, response)
+	}
+	switch rule := rule.(type) {
+	case *vpcv1.NetworkACLRuleNetworkACLRuleProtocolIcmp:
+		return *rule.ID, nil
+	case *vpcv1.NetworkACLRuleNetworkACLRuleProtocolTcpudp:
+		return *rule.ID, nil
+	case *vpcv1.NetworkACLRuleNetworkACLRuleProtocolAll:
+		return *rule.ID, nil
+	}
+	return "", nil
 }
 
 func isNil(i interface{}) bool {
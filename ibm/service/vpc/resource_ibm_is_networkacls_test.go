@@ -6,6 +6,7 @@ package vpc_test
 import (
 	"errors"
 	"fmt"
+	"regexp"
 	"testing"
 
 	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
@@ -102,8 +103,33 @@ func TestNetworkACLGen2(t *testing.T) {
 						"ibm_is_network_acl.isExampleACL", "rules.#", "2"),
 					resource.TestCheckResourceAttr(
 						"ibm_is_network_acl.isExampleACL", "tags.#", "2"),
+					resource.TestCheckResourceAttr(
+						"ibm_is_network_acl.isExampleACL", "managed_rule_names.#", "2"),
 				),
 			},
+			{
+				ResourceName: "ibm_is_network_acl.isExampleACL",
+				ImportState:  true,
+				ImportStateCheck: func(states []*terraform.InstanceState) error {
+					if len(states) != 1 || states[0].Attributes["managed_rule_names.#"] != "2" {
+						return fmt.Errorf("[ERROR] Imported network ACL does not manage its 2 rules: %v", states)
+					}
+					return nil
+				},
+			},
+			{
+				Config: testAccCheckIBMISNetworkACLConfig1() + `
+	resource "ibm_is_network_acl_rule" "isExampleACLRule" {
+		network_acl = ibm_is_network_acl.isExampleACL.id
+		name        = "standalone"
+		action      = "deny"
+		source      = "0.0.0.0/0"
+		destination = "0.0.0.0/0"
+		direction   = "inbound"
+	}
+	`,
+				ExpectError: regexp.MustCompile("has rules that are not declared in the rules block: standalone"),
+			},
 		},
 	})
 }
@@ -9,10 +9,13 @@ import (
 	"log"
 	"os"
 	"reflect"
+	"strings"
 	"time"
 
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	"github.com/IBM/go-sdk-core/v5/core"
 	"github.com/IBM/vpc-go-sdk/vpcv1"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
@@ -27,6 +30,7 @@ const (
 	isSecurityGroupTags          = "tags"
 	isSecurityGroupAccessTags    = "access_tags"
 	isSecurityGroupCRN           = "crn"
+	isSecurityGroupInlineRule    = "rule"
 )
 
 func ResourceIBMISSecurityGroup() *schema.Resource {
@@ -49,10 +53,15 @@ func ResourceIBMISSecurityGroup() *schema.Resource {
 				func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
 					return flex.ResourceValidateAccessTags(diff, v)
 				}),
+			customdiff.Sequence(
+				func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
+					return resourceIBMISSecurityGroupInlineRulesCustomizeDiff(diff)
+				}),
 		),
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
 			Delete: schema.DefaultTimeout(10 * time.Minute),
 		},
 
@@ -105,6 +114,65 @@ func ResourceIBMISSecurityGroup() *schema.Resource {
 				},
 			},
 
+			isSecurityGroupInlineRule: {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Description: "Authoritative set of security group rules. When specified, the plan fails if the security group has rules that are not declared here",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						isSecurityGroupRuleDirection: {
+							Type:         schema.TypeString,
+							Required:     true,
+							Description:  "Direction of traffic to enforce, either inbound or outbound",
+							ValidateFunc: validate.InvokeValidator("ibm_is_security_group_rule", isSecurityGroupRuleDirection),
+						},
+						isSecurityGroupRuleIPVersion: {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      isSecurityGroupRuleIPVersionDefault,
+							Description:  "IP version: ipv4",
+							ValidateFunc: validate.InvokeValidator("ibm_is_security_group_rule", isSecurityGroupRuleIPVersion),
+						},
+						isSecurityGroupRuleRemote: {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "Security group id: an IP address, a CIDR block, or a single security group identifier",
+						},
+						isSecurityGroupRuleProtocol: {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      "all",
+							Description:  "The protocol to enforce: all, icmp, tcp or udp",
+							ValidateFunc: validate.InvokeValidator("ibm_is_security_group", isSecurityGroupRuleProtocol),
+						},
+						isSecurityGroupRuleType: {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Description:  "The ICMP traffic type to allow",
+							ValidateFunc: validate.InvokeValidator("ibm_is_security_group_rule", isSecurityGroupRuleType),
+						},
+						isSecurityGroupRuleCode: {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Description:  "The ICMP traffic code to allow",
+							ValidateFunc: validate.InvokeValidator("ibm_is_security_group_rule", isSecurityGroupRuleCode),
+						},
+						isSecurityGroupRulePortMin: {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Description:  "The inclusive lower bound of TCP/UDP port range",
+							ValidateFunc: validate.InvokeValidator("ibm_is_security_group_rule", isSecurityGroupRulePortMin),
+						},
+						isSecurityGroupRulePortMax: {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Description:  "The inclusive upper bound of TCP/UDP port range",
+							ValidateFunc: validate.InvokeValidator("ibm_is_security_group_rule", isSecurityGroupRulePortMax),
+						},
+					},
+				},
+			},
+
 			isSecurityGroupResourceGroup: {
 				Type:        schema.TypeString,
 				Optional:    true,
@@ -173,6 +241,14 @@ func ResourceIBMISSecurityGroupValidator() *validate.ResourceValidator {
 			MinValueLength:             1,
 			MaxValueLength:             128})
 
+	validateSchema = append(validateSchema,
+		validate.ValidateSchema{
+			Identifier:                 isSecurityGroupRuleProtocol,
+			ValidateFunctionIdentifier: validate.ValidateAllowedStringValue,
+			Type:                       validate.TypeString,
+			Optional:                   true,
+			AllowedValues:              "all, icmp, tcp, udp"})
+
 	ibmISSecurityGroupResourceValidator := validate.ResourceValidator{ResourceName: "ibm_is_security_group", Schema: validateSchema}
 	return &ibmISSecurityGroupResourceValidator
 }
@@ -205,6 +281,12 @@ func resourceIBMISSecurityGroupCreate(d *schema.ResourceData, meta interface{})
 		return fmt.Errorf("[ERROR] Error while creating Security Group %s\n%s", err, response)
 	}
 	d.SetId(*sg.ID)
+	if rules, ok := d.GetOk(isSecurityGroupInlineRule); ok {
+		err = reconcileSecurityGroupInlineRules(sess, *sg.ID, nil, rules.(*schema.Set).List())
+		if err != nil {
+			return err
+		}
+	}
 	v := os.Getenv("IC_ENV_TAGS")
 	if _, ok := d.GetOk(isSecurityGroupTags); ok || v != "" {
 		oldList, newList := d.GetChange(isSecurityGroupTags)
@@ -259,94 +341,15 @@ func resourceIBMISSecurityGroupRead(d *schema.ResourceData, meta interface{}) er
 	d.Set(isSecurityGroupName, *group.Name)
 	d.Set(isSecurityGroupVPC, *group.VPC.ID)
 	rules := make([]map[string]interface{}, 0)
-	if len(group.Rules) > 0 {
-		for _, rule := range group.Rules {
-			switch reflect.TypeOf(rule).String() {
-			case "*vpcv1.SecurityGroupRuleSecurityGroupRuleProtocolIcmp":
-				{
-					rule := rule.(*vpcv1.SecurityGroupRuleSecurityGroupRuleProtocolIcmp)
-					r := make(map[string]interface{})
-					if rule.Code != nil {
-						r[isSecurityGroupRuleCode] = int(*rule.Code)
-					}
-					if rule.Type != nil {
-						r[isSecurityGroupRuleType] = int(*rule.Type)
-					}
-					r[isSecurityGroupRuleDirection] = *rule.Direction
-					r[isSecurityGroupRuleIPVersion] = *rule.IPVersion
-					if rule.Protocol != nil {
-						r[isSecurityGroupRuleProtocol] = *rule.Protocol
-					}
-					remote, ok := rule.Remote.(*vpcv1.SecurityGroupRuleRemote)
-					if ok {
-						if remote != nil && reflect.ValueOf(remote).IsNil() == false {
-							if remote.ID != nil {
-								r[isSecurityGroupRuleRemote] = remote.ID
-							} else if remote.Address != nil {
-								r[isSecurityGroupRuleRemote] = remote.Address
-							} else if remote.CIDRBlock != nil {
-								r[isSecurityGroupRuleRemote] = remote.CIDRBlock
-							}
-						}
-					}
-					rules = append(rules, r)
-				}
-			case "*vpcv1.SecurityGroupRuleSecurityGroupRuleProtocolAll":
-				{
-					rule := rule.(*vpcv1.SecurityGroupRuleSecurityGroupRuleProtocolAll)
-					r := make(map[string]interface{})
-					r[isSecurityGroupRuleDirection] = *rule.Direction
-					r[isSecurityGroupRuleIPVersion] = *rule.IPVersion
-					if rule.Protocol != nil {
-						r[isSecurityGroupRuleProtocol] = *rule.Protocol
-					}
-					remote, ok := rule.Remote.(*vpcv1.SecurityGroupRuleRemote)
-					if ok {
-						if remote != nil && reflect.ValueOf(remote).IsNil() == false {
-							if remote.ID != nil {
-								r[isSecurityGroupRuleRemote] = remote.ID
-							} else if remote.Address != nil {
-								r[isSecurityGroupRuleRemote] = remote.Address
-							} else if remote.CIDRBlock != nil {
-								r[isSecurityGroupRuleRemote] = remote.CIDRBlock
-							}
-						}
-					}
-					rules = append(rules, r)
-				}
-			case "*vpcv1.SecurityGroupRuleSecurityGroupRuleProtocolTcpudp":
-				{
-					rule := rule.(*vpcv1.SecurityGroupRuleSecurityGroupRuleProtocolTcpudp)
-					r := make(map[string]interface{})
-					if rule.PortMin != nil {
-						r[isSecurityGroupRulePortMin] = int(*rule.PortMin)
-					}
-					if rule.PortMax != nil {
-						r[isSecurityGroupRulePortMax] = int(*rule.PortMax)
-					}
-					r[isSecurityGroupRuleDirection] = *rule.Direction
-					r[isSecurityGroupRuleIPVersion] = *rule.IPVersion
-					if rule.Protocol != nil {
-						r[isSecurityGroupRuleProtocol] = *rule.Protocol
-					}
-					remote, ok := rule.Remote.(*vpcv1.SecurityGroupRuleRemote)
-					if ok {
-						if remote != nil && reflect.ValueOf(remote).IsNil() == false {
-							if remote.ID != nil {
-								r[isSecurityGroupRuleRemote] = remote.ID
-							} else if remote.Address != nil {
-								r[isSecurityGroupRuleRemote] = remote.Address
-							} else if remote.CIDRBlock != nil {
-								r[isSecurityGroupRuleRemote] = remote.CIDRBlock
-							}
-						}
-					}
-					rules = append(rules, r)
-				}
-			}
+	for _, rule := range group.Rules {
+		if _, r := securityGroupRuleToMap(rule); r != nil {
+			rules = append(rules, r)
 		}
 	}
 	d.Set(isSecurityGroupRules, rules)
+	if _, ok := d.GetOk(isSecurityGroupInlineRule); ok {
+		d.Set(isSecurityGroupInlineRule, flattenSecurityGroupInlineRules(rules, d.Get(isSecurityGroupInlineRule).(*schema.Set).List()))
+	}
 	d.SetId(*group.ID)
 	if group.ResourceGroup != nil {
 		d.Set(isSecurityGroupResourceGroup, group.ResourceGroup.ID)
@@ -387,6 +390,13 @@ func resourceIBMISSecurityGroupUpdate(d *schema.ResourceData, meta interface{})
 				"Error on update of Security Group (%s) access tags: %s", d.Id(), err)
 		}
 	}
+	if d.HasChange(isSecurityGroupInlineRule) {
+		oldRules, newRules := d.GetChange(isSecurityGroupInlineRule)
+		err := reconcileSecurityGroupInlineRules(sess, id, oldRules.(*schema.Set).List(), newRules.(*schema.Set).List())
+		if err != nil {
+			return err
+		}
+	}
 	if d.HasChange(isSecurityGroupName) {
 		name = d.Get(isSecurityGroupName).(string)
 		hasChanged = true
@@ -575,6 +585,330 @@ func makeIBMISSecurityRuleSchema() map[string]*schema.Schema {
 	}
 }
 
+// securityGroupRuleToMap flattens a security group rule into the shape used by
+// the rules attribute and returns the rule ID alongside it.
+func securityGroupRuleToMap(ruleIntf vpcv1.SecurityGroupRuleIntf) (string, map[string]interface{}) {
+	var id string
+	var remoteIntf vpcv1.SecurityGroupRuleRemoteIntf
+	r := make(map[string]interface{})
+	switch reflect.TypeOf(ruleIntf).String() {
+	case "*vpcv1.SecurityGroupRuleSecurityGroupRuleProtocolIcmp":
+		{
+			rule := ruleIntf.(*vpcv1.SecurityGroupRuleSecurityGroupRuleProtocolIcmp)
+			id = *rule.ID
+			if rule.Code != nil {
+				r[isSecurityGroupRuleCode] = int(*rule.Code)
+			}
+			if rule.Type != nil {
+				r[isSecurityGroupRuleType] = int(*rule.Type)
+			}
+			r[isSecurityGroupRuleDirection] = *rule.Direction
+			r[isSecurityGroupRuleIPVersion] = *rule.IPVersion
+			if rule.Protocol != nil {
+				r[isSecurityGroupRuleProtocol] = *rule.Protocol
+			}
+			remoteIntf = rule.Remote
+		}
+	case "*vpcv1.SecurityGroupRuleSecurityGroupRuleProtocolAll":
+		{
+			rule := ruleIntf.(*vpcv1.SecurityGroupRuleSecurityGroupRuleProtocolAll)
+			id = *rule.ID
+			r[isSecurityGroupRuleDirection] = *rule.Direction
+			r[isSecurityGroupRuleIPVersion] = *rule.IPVersion
+			if rule.Protocol != nil {
+				r[isSecurityGroupRuleProtocol] = *rule.Protocol
+			}
+			remoteIntf = rule.Remote
+		}
+	case "*vpcv1.SecurityGroupRuleSecurityGroupRuleProtocolTcpudp":
+		{
+			rule := ruleIntf.(*vpcv1.SecurityGroupRuleSecurityGroupRuleProtocolTcpudp)
+			id = *rule.ID
+			if rule.PortMin != nil {
+				r[isSecurityGroupRulePortMin] = int(*rule.PortMin)
+			}
+			if rule.PortMax != nil {
+				r[isSecurityGroupRulePortMax] = int(*rule.PortMax)
+			}
+			r[isSecurityGroupRuleDirection] = *rule.Direction
+			r[isSecurityGroupRuleIPVersion] = *rule.IPVersion
+			if rule.Protocol != nil {
+				r[isSecurityGroupRuleProtocol] = *rule.Protocol
+			}
+			remoteIntf = rule.Remote
+		}
+	default:
+		return "", nil
+	}
+	remote, ok := remoteIntf.(*vpcv1.SecurityGroupRuleRemote)
+	if ok && remote != nil && !reflect.ValueOf(remote).IsNil() {
+		if remote.ID != nil {
+			r[isSecurityGroupRuleRemote] = *remote.ID
+		} else if remote.Address != nil {
+			r[isSecurityGroupRuleRemote] = *remote.Address
+		} else if remote.CIDRBlock != nil {
+			r[isSecurityGroupRuleRemote] = *remote.CIDRBlock
+		}
+	}
+	return id, r
+}
+
+func resourceIBMISSecurityGroupInlineRulesCustomizeDiff(diff *schema.ResourceDiff) error {
+	newRules := diff.Get(isSecurityGroupInlineRule).(*schema.Set).List()
+	for _, ruleIntf := range newRules {
+		rule := ruleIntf.(map[string]interface{})
+		protocol := rule[isSecurityGroupRuleProtocol].(string)
+		icmpType, icmpCode := rule[isSecurityGroupRuleType].(int), rule[isSecurityGroupRuleCode].(int)
+		portMin, portMax := rule[isSecurityGroupRulePortMin].(int), rule[isSecurityGroupRulePortMax].(int)
+		if protocol != isSecurityGroupRuleProtocolICMP && (icmpType != 0 || icmpCode != 0) {
+			return fmt.Errorf("[ERROR] Security group rule with protocol %s cannot specify %s or %s, they are only supported with protocol icmp", protocol, isSecurityGroupRuleType, isSecurityGroupRuleCode)
+		}
+		if icmpCode != 0 && icmpType == 0 {
+			return fmt.Errorf("[ERROR] Security group rule icmp code requires icmp type")
+		}
+		if protocol != isSecurityGroupRuleProtocolTCP && protocol != isSecurityGroupRuleProtocolUDP && (portMin != 0 || portMax != 0) {
+			return fmt.Errorf("[ERROR] Security group rule with protocol %s cannot specify %s or %s, they are only supported with protocol tcp or udp", protocol, isSecurityGroupRulePortMin, isSecurityGroupRulePortMax)
+		}
+		if portMin != 0 && portMax != 0 && portMin > portMax {
+			return fmt.Errorf("[ERROR] Security group rule %s (%d) must be less than or equal to %s (%d)", isSecurityGroupRulePortMin, portMin, isSecurityGroupRulePortMax, portMax)
+		}
+	}
+
+	// The rules of the security group are refreshed into the rules attribute. A rule that is not declared in a rule
+	// block now and was not declared before is managed elsewhere, by ibm_is_security_group_rule or out-of-band.
+	if diff.Id() == "" || len(newRules) == 0 || !diff.NewValueKnown(isSecurityGroupInlineRule) {
+		return nil
+	}
+	oldRules, _ := diff.GetChange(isSecurityGroupInlineRule)
+	actual := []map[string]interface{}{}
+	for _, r := range diff.Get(isSecurityGroupRules).([]interface{}) {
+		actual = append(actual, r.(map[string]interface{}))
+	}
+	_, unmanaged := planSecurityGroupInlineRules(actual, oldRules.(*schema.Set).List(), newRules)
+	if len(unmanaged) > 0 {
+		return securityGroupUnmanagedRulesError(diff.Id(), unmanaged)
+	}
+	return nil
+}
+
+// planSecurityGroupInlineRules matches the rules of the security group against the declared rule blocks. It returns
+// the indexes of the rules that were declared before but are no longer declared, and the rules that were never
+// declared.
+func planSecurityGroupInlineRules(actual []map[string]interface{}, oldRules, newRules []interface{}) ([]int, []map[string]interface{}) {
+	matchedNew := make([]bool, len(newRules))
+	matchedOld := make([]bool, len(oldRules))
+	removed := []int{}
+	unmanaged := []map[string]interface{}{}
+	for i, r := range actual {
+		if j := findSecurityGroupInlineRule(newRules, matchedNew, r); j >= 0 {
+			matchedNew[j] = true
+			continue
+		}
+		if j := findSecurityGroupInlineRule(oldRules, matchedOld, r); j >= 0 {
+			matchedOld[j] = true
+			removed = append(removed, i)
+			continue
+		}
+		unmanaged = append(unmanaged, r)
+	}
+	return removed, unmanaged
+}
+
+func securityGroupUnmanagedRulesError(sgID string, unmanaged []map[string]interface{}) error {
+	descriptions := make([]string, 0, len(unmanaged))
+	for _, r := range unmanaged {
+		description := fmt.Sprintf("%v %v", r[isSecurityGroupRuleDirection], r[isSecurityGroupRuleProtocol])
+		if remote, _ := r[isSecurityGroupRuleRemote].(string); remote != "" {
+			description += " " + remote
+		}
+		if portMin, _ := r[isSecurityGroupRulePortMin].(int); portMin != 0 {
+			description += fmt.Sprintf(" %d-%v", portMin, r[isSecurityGroupRulePortMax])
+		}
+		descriptions = append(descriptions, description)
+	}
+	return fmt.Errorf("[ERROR] Security group (%s) has rules that are not declared in %s blocks: %s. "+
+		"Rules managed by ibm_is_security_group_rule resources can't be combined with %s blocks on the same security group. "+
+		"Declare the rules in %s blocks, or delete them from the security group", sgID, isSecurityGroupInlineRule, strings.Join(descriptions, ", "), isSecurityGroupInlineRule, isSecurityGroupInlineRule)
+}
+
+// securityGroupInlineRulePorts applies the same defaulting as ibm_is_security_group_rule:
+// an unset range covers all ports and a single bound applies to both ends.
+func securityGroupInlineRulePorts(rule map[string]interface{}) (int, int) {
+	portMin, _ := rule[isSecurityGroupRulePortMin].(int)
+	portMax, _ := rule[isSecurityGroupRulePortMax].(int)
+	switch {
+	case portMin == 0 && portMax == 0:
+		return 1, 65535
+	case portMin == 0:
+		return portMax, portMax
+	case portMax == 0:
+		return portMin, portMin
+	}
+	return portMin, portMax
+}
+
+// securityGroupInlineRuleMatches reports whether the declared rule describes
+// the rule returned by the API.
+func securityGroupInlineRuleMatches(declared, actual map[string]interface{}) bool {
+	protocol, _ := declared[isSecurityGroupRuleProtocol].(string)
+	if protocol == "" {
+		protocol = "all"
+	}
+	if declared[isSecurityGroupRuleDirection] != actual[isSecurityGroupRuleDirection] ||
+		!strings.EqualFold(declared[isSecurityGroupRuleIPVersion].(string), actual[isSecurityGroupRuleIPVersion].(string)) ||
+		protocol != actual[isSecurityGroupRuleProtocol] {
+		return false
+	}
+	declaredRemote, _ := declared[isSecurityGroupRuleRemote].(string)
+	actualRemote, _ := actual[isSecurityGroupRuleRemote].(string)
+	if declaredRemote != actualRemote && !(declaredRemote == "" && actualRemote == "0.0.0.0/0") {
+		return false
+	}
+	switch protocol {
+	case isSecurityGroupRuleProtocolICMP:
+		declaredType, _ := declared[isSecurityGroupRuleType].(int)
+		declaredCode, _ := declared[isSecurityGroupRuleCode].(int)
+		actualType, _ := actual[isSecurityGroupRuleType].(int)
+		actualCode, _ := actual[isSecurityGroupRuleCode].(int)
+		return declaredType == actualType && declaredCode == actualCode
+	case isSecurityGroupRuleProtocolTCP, isSecurityGroupRuleProtocolUDP:
+		portMin, portMax := securityGroupInlineRulePorts(declared)
+		return portMin == actual[isSecurityGroupRulePortMin] && portMax == actual[isSecurityGroupRulePortMax]
+	}
+	return true
+}
+
+func findSecurityGroupInlineRule(declared []interface{}, used []bool, actual map[string]interface{}) int {
+	for i, rule := range declared {
+		if !used[i] && securityGroupInlineRuleMatches(rule.(map[string]interface{}), actual) {
+			return i
+		}
+	}
+	return -1
+}
+
+// flattenSecurityGroupInlineRules keeps the declared rules that still exist, so that deleted rules show up in the
+// plan. Rules that were not declared are reported by the plan time conflict check instead.
+func flattenSecurityGroupInlineRules(actual []map[string]interface{}, declared []interface{}) []interface{} {
+	used := make([]bool, len(declared))
+	rules := make([]interface{}, 0, len(declared))
+	for _, r := range actual {
+		if i := findSecurityGroupInlineRule(declared, used, r); i >= 0 {
+			used[i] = true
+			rules = append(rules, declared[i])
+		}
+	}
+	return rules
+}
+
+func expandSecurityGroupInlineRule(rule map[string]interface{}) (*vpcv1.SecurityGroupRulePrototype, error) {
+	direction := rule[isSecurityGroupRuleDirection].(string)
+	ipVersion := rule[isSecurityGroupRuleIPVersion].(string)
+	protocol := rule[isSecurityGroupRuleProtocol].(string)
+	prototype := &vpcv1.SecurityGroupRulePrototype{
+		Direction: &direction,
+		IPVersion: &ipVersion,
+		Protocol:  &protocol,
+	}
+	if remote, ok := rule[isSecurityGroupRuleRemote].(string); ok && remote != "" {
+		address, cidr, id, err := inferRemoteSecurityGroup(remote)
+		if err != nil {
+			return nil, err
+		}
+		remoteTemplate := &vpcv1.SecurityGroupRuleRemotePrototype{}
+		if address != "" {
+			remoteTemplate.Address = &address
+		} else if cidr != "" {
+			remoteTemplate.CIDRBlock = &cidr
+		} else {
+			remoteTemplate.ID = &id
+		}
+		prototype.Remote = remoteTemplate
+	}
+	switch protocol {
+	case isSecurityGroupRuleProtocolICMP:
+		if icmpType := int64(rule[isSecurityGroupRuleType].(int)); icmpType != 0 {
+			prototype.Type = &icmpType
+			if icmpCode := int64(rule[isSecurityGroupRuleCode].(int)); icmpCode != 0 {
+				prototype.Code = &icmpCode
+			}
+		}
+	case isSecurityGroupRuleProtocolTCP, isSecurityGroupRuleProtocolUDP:
+		portMin, portMax := securityGroupInlineRulePorts(rule)
+		prototype.PortMin = core.Int64Ptr(int64(portMin))
+		prototype.PortMax = core.Int64Ptr(int64(portMax))
+	}
+	return prototype, nil
+}
+
+// reconcileSecurityGroupInlineRules makes the rules of the security group match the
+// declared rule blocks. Rules that were removed from the rule blocks are deleted and
+// missing rules are created. Rules that were never declared are managed elsewhere and
+// fail the reconciliation without deleting anything.
+func reconcileSecurityGroupInlineRules(sess *vpcv1.VpcV1, sgID string, oldRules, newRules []interface{}) error {
+	if len(newRules) == 0 {
+		log.Printf("[INFO] Security group (%s) rule blocks removed, existing rules are left in place and no longer managed", sgID)
+		return nil
+	}
+	isSecurityGroupRuleKey := "security_group_rule_key_" + sgID
+	conns.IbmMutexKV.Lock(isSecurityGroupRuleKey)
+	defer conns.IbmMutexKV.Unlock(isSecurityGroupRuleKey)
+
+	getSecurityGroupOptions := &vpcv1.GetSecurityGroupOptions{
+		ID: &sgID,
+	}
+	group, response, err := sess.GetSecurityGroup(getSecurityGroupOptions)
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error getting Security Group (%s): %s\n%s", sgID, err, response)
+	}
+	ruleIDs := []string{}
+	actual := []map[string]interface{}{}
+	for _, ruleIntf := range group.Rules {
+		if ruleID, r := securityGroupRuleToMap(ruleIntf); r != nil {
+			ruleIDs = append(ruleIDs, ruleID)
+			actual = append(actual, r)
+		}
+	}
+	removed, unmanaged := planSecurityGroupInlineRules(actual, oldRules, newRules)
+	if len(unmanaged) > 0 {
+		return securityGroupUnmanagedRulesError(sgID, unmanaged)
+	}
+	for _, i := range removed {
+		deleteSecurityGroupRuleOptions := &vpcv1.DeleteSecurityGroupRuleOptions{
+			SecurityGroupID: &sgID,
+			ID:              &ruleIDs[i],
+		}
+		response, err := sess.DeleteSecurityGroupRule(deleteSecurityGroupRuleOptions)
+		if err != nil && (response == nil || response.StatusCode != 404) {
+			return fmt.Errorf("[ERROR] Error deleting Security Group (%s) rule (%s): %s\n%s", sgID, ruleIDs[i], err, response)
+		}
+	}
+	matched := make([]bool, len(newRules))
+	for _, r := range actual {
+		if i := findSecurityGroupInlineRule(newRules, matched, r); i >= 0 {
+			matched[i] = true
+		}
+	}
+	for i, ruleIntf := range newRules {
+		if matched[i] {
+			continue
+		}
+		prototype, err := expandSecurityGroupInlineRule(ruleIntf.(map[string]interface{}))
+		if err != nil {
+			return err
+		}
+		createSecurityGroupRuleOptions := &vpcv1.CreateSecurityGroupRuleOptions{
+			SecurityGroupID:            &sgID,
+			SecurityGroupRulePrototype: prototype,
+		}
+		_, response, err := sess.CreateSecurityGroupRule(createSecurityGroupRuleOptions)
+		if err != nil {
+			return fmt.Errorf("[ERROR] Error creating Security Group (%s) rule: %s\n%s", sgID, err, response)
+		}
+	}
+	return nil
+}
+
 func isWaitForTargetDeleted(client *vpcv1.VpcV1, sgId, targetId string, target vpcv1.SecurityGroupTargetReferenceIntf, timeout time.Duration) (interface{}, error) {
 	log.Printf("Waiting for Security group(%s) target(%s) to be deleted.", sgId, targetId)
 
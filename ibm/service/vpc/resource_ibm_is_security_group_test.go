@@ -6,6 +6,7 @@ package vpc_test
 import (
 	"errors"
 	"fmt"
+	"regexp"
 	"strings"
 	"testing"
 
@@ -53,6 +54,50 @@ func TestAccIBMISSecurityGroup_basic(t *testing.T) {
 		},
 	})
 }
+func TestAccIBMISSecurityGroup_inlineRules(t *testing.T) {
+	var securityGroup string
+
+	vpcname := fmt.Sprintf("tfsg-vpc-%d", acctest.RandIntRange(10, 100))
+	name := fmt.Sprintf("tfsg-inline-%d", acctest.RandIntRange(10, 100))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckIBMISSecurityGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMISsecurityGroupInlineRulesConfig(vpcname, name, 22),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIBMISSecurityGroupExists("ibm_is_security_group.testacc_security_group", securityGroup),
+					resource.TestCheckResourceAttr(
+						"ibm_is_security_group.testacc_security_group", "rule.#", "2"),
+					resource.TestCheckResourceAttr(
+						"ibm_is_security_group.testacc_security_group", "rules.#", "2"),
+				),
+			},
+			{
+				Config: testAccCheckIBMISsecurityGroupInlineRulesConfig(vpcname, name, 443),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIBMISSecurityGroupExists("ibm_is_security_group.testacc_security_group", securityGroup),
+					resource.TestCheckResourceAttr(
+						"ibm_is_security_group.testacc_security_group", "rule.#", "2"),
+					resource.TestCheckResourceAttr(
+						"ibm_is_security_group.testacc_security_group", "rules.#", "2"),
+				),
+			},
+			{
+				Config: testAccCheckIBMISsecurityGroupInlineRulesConfig(vpcname, name, 443) + `
+resource "ibm_is_security_group_rule" "testacc_security_group_rule" {
+	group     = ibm_is_security_group.testacc_security_group.id
+	direction = "inbound"
+	remote    = "192.168.0.0/16"
+}`,
+				ExpectError: regexp.MustCompile("has rules that are not declared in rule blocks"),
+			},
+		},
+	})
+}
+
 func TestAccIBMISSecurityGroup_wait(t *testing.T) {
 	var securityGroup string
 
@@ -154,6 +199,28 @@ resource "ibm_is_security_group" "testacc_security_group" {
 	tags = ["Tag1", "tag2"]
 }`, vpcname, name)
 
+}
+func testAccCheckIBMISsecurityGroupInlineRulesConfig(vpcname, name string, port int) string {
+	return fmt.Sprintf(`
+resource "ibm_is_vpc" "testacc_vpc" {
+	name = "%s"
+}
+
+resource "ibm_is_security_group" "testacc_security_group" {
+	name = "%s"
+	vpc  = ibm_is_vpc.testacc_vpc.id
+	rule {
+		direction = "inbound"
+		remote    = "10.0.0.0/8"
+		protocol  = "tcp"
+		port_min  = %d
+		port_max  = %d
+	}
+	rule {
+		direction = "outbound"
+	}
+}`, vpcname, name, port, port)
+
 }
 func testAccCheckIBMISsecurityGroupWaitConfig(name, vpcname, subnetname, sshname, publicKey, vsiname, bmname string) string {
 	return fmt.Sprintf(`
@@ -50,6 +50,11 @@ func ResourceIbmIsShare() *schema.Resource {
 					return flex.ResourceValidateAccessTags(diff, v)
 				},
 			),
+			customdiff.Sequence(
+				func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
+					return resourceIbmIsShareOriginShareCustomizeDiff(diff)
+				},
+			),
 		),
 
 		Schema: map[string]*schema.Schema{
@@ -104,8 +109,8 @@ func ResourceIbmIsShare() *schema.Resource {
 				Type:          schema.TypeInt,
 				Optional:      true,
 				Computed:      true,
-				ExactlyOneOf:  []string{"size", "source_share", "source_share_crn"},
-				ConflictsWith: []string{"replication_cron_spec", "source_share", "source_share_crn"},
+				ExactlyOneOf:  []string{"size", "source_share", "source_share_crn", "origin_share"},
+				ConflictsWith: []string{"replication_cron_spec", "source_share", "source_share_crn", "origin_share"},
 				ValidateFunc:  validate.InvokeValidator("ibm_is_share", "size"),
 				Description:   "The size of the file share rounded up to the next gigabyte.",
 			},
@@ -263,9 +268,84 @@ func ResourceIbmIsShare() *schema.Resource {
 				Description:  "The unique user-defined name for this file share. If unspecified, the name will be a hyphenated list of randomly-selected words.",
 			},
 			"profile": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ConflictsWith: []string{"origin_share"},
+				Description:   "The globally unique name for this share profile. Required unless `origin_share` is specified.",
+			},
+			"origin_share": {
+				Type:          schema.TypeList,
+				Optional:      true,
+				ForceNew:      true,
+				MaxItems:      1,
+				ConflictsWith: []string{"replica_share", "source_share", "source_share_crn", "initial_owner", "encryption_key", "iops"},
+				Description:   "The origin share this accessor share is referring to. The origin share may be in another account, the share is created as an accessor share with an accessor binding to the origin share.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"crn": {
+							Type:        schema.TypeString,
+							Required:    true,
+							ForceNew:    true,
+							Description: "The CRN of the origin share.",
+						},
+						"href": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The URL for the origin share.",
+						},
+						"id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The unique identifier of the origin share.",
+						},
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The name of the origin share.",
+						},
+					},
+				},
+			},
+			"accessor_binding_role": {
 				Type:        schema.TypeString,
-				Required:    true,
-				Description: "The globally unique name for this share profile.",
+				Computed:    true,
+				Description: "The accessor binding role of this share.* `accessor`: This share is an accessor share of an origin share.* `origin`: This share has accessor bindings.* `none`: This share is neither an accessor nor an origin share.",
+			},
+			"accessor_bindings": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The accessor bindings for this share. Each accessor binding identifies a resource (possibly in another account) with access to this share's data.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"href": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The URL for this share accessor binding.",
+						},
+						"id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The unique identifier for this share accessor binding.",
+						},
+					},
+				},
+			},
+			"allowed_transfer_protocols": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString, ValidateFunc: validate.InvokeValidator("ibm_is_share", "allowed_transfer_protocols")},
+				Set:         schema.HashString,
+				Description: "The transfer protocols to allow for mount targets of this share.",
+			},
+			"allowed_transit_encryption_modes": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString, ValidateFunc: validate.InvokeValidator("ibm_is_share", "allowed_transit_encryption_modes")},
+				Set:         schema.HashString,
+				Description: "The transit encryption modes to allow for mount targets of this share.",
 			},
 			"replica_share": &schema.Schema{
 				Type:          schema.TypeList,
@@ -644,10 +724,12 @@ func ResourceIbmIsShare() *schema.Resource {
 				Description: "The lifecycle state of the file share.",
 			},
 			"zone": {
-				Type:        schema.TypeString,
-				Required:    true,
-				ForceNew:    true,
-				Description: "The globally unique name of the zone this file share will reside in.",
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"origin_share"},
+				Description:   "The globally unique name of the zone this file share will reside in. Required unless `origin_share` is specified.",
 			},
 			isFileShareTags: {
 				Type:        schema.TypeSet,
@@ -740,6 +822,20 @@ func ResourceIbmIsShareValidator() *validate.ResourceValidator {
 			MinValueLength:             1,
 			MaxValueLength:             128,
 		},
+		validate.ValidateSchema{
+			Identifier:                 "allowed_transfer_protocols",
+			ValidateFunctionIdentifier: validate.ValidateAllowedStringValue,
+			Type:                       validate.TypeString,
+			Optional:                   true,
+			AllowedValues:              "tcp, udp",
+		},
+		validate.ValidateSchema{
+			Identifier:                 "allowed_transit_encryption_modes",
+			ValidateFunctionIdentifier: validate.ValidateAllowedStringValue,
+			Type:                       validate.TypeString,
+			Optional:                   true,
+			AllowedValues:              "none, stunnel, user_managed",
+		},
 	)
 
 	resourceValidator := validate.ResourceValidator{ResourceName: "ibm_is_share", Schema: validateSchema}
@@ -850,7 +946,7 @@ func resourceIbmIsShareCreate(context context.Context, d *schema.ResourceData, m
 			}
 			sharePrototype.ReplicaShare = replicaShare
 		}
-	} else {
+	} else if _, ok := d.GetOk("origin_share"); !ok {
 		sourceShare := d.Get("source_share").(string)
 		if sourceShare != "" {
 			sharePrototype.SourceShare = &vpcv1.ShareIdentity{
@@ -922,7 +1018,7 @@ func resourceIbmIsShareCreate(context context.Context, d *schema.ResourceData, m
 			sharePrototype.UserTags = userTagsArray
 		}
 	}
-	createShareOptions.SetSharePrototype(sharePrototype)
+	createShareOptions.SetSharePrototype(shareAccessorPrototype(d, sharePrototype))
 	share, response, err := vpcClient.CreateShareWithContext(context, createShareOptions)
 	if err != nil {
 		log.Printf("[DEBUG] CreateShareWithContext failed %s\n%s", err, response)
@@ -1115,6 +1211,13 @@ func resourceIbmIsShareRead(context context.Context, d *schema.ResourceData, met
 	if err = d.Set("resource_type", share.ResourceType); err != nil {
 		return diag.FromErr(fmt.Errorf("Error setting resource_type: %s", err))
 	}
+	accessorOptions, err := getShareAccessorOptions(vpcClient, d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if err = setShareAccessorOptions(d, accessorOptions); err != nil {
+		return diag.FromErr(err)
+	}
 
 	latest_syncs := []map[string]interface{}{}
 	if share.LatestSync != nil {
@@ -1633,6 +1736,13 @@ func shareUpdate(vpcClient *vpcv1.VpcV1, context context.Context, d *schema.Reso
 		}
 		hasChange = true
 	}
+	accessorOptionsPatch := map[string]interface{}{}
+	if shareType == "share" {
+		accessorOptionsPatch = shareAccessorOptionsPatch(d)
+		if len(accessorOptionsPatch) > 0 {
+			hasChange = true
+		}
+	}
 	if hasChange {
 
 		sharePatch, err := sharePatchModel.AsPatch()
@@ -1641,6 +1751,9 @@ func shareUpdate(vpcClient *vpcv1.VpcV1, context context.Context, d *schema.Reso
 			log.Printf("[DEBUG] SharePatch AsPatch failed %s", err)
 			return err
 		}
+		for key, value := range accessorOptionsPatch {
+			sharePatch[key] = value
+		}
 		updateShareOptions.SetSharePatch(sharePatch)
 		if hasSizeChanged {
 			_, err = isWaitForShareAvailable(context, vpcClient, d.Id(), d, d.Timeout(schema.TimeoutCreate))
@@ -1833,3 +1946,139 @@ func shareUpdate(vpcClient *vpcv1.VpcV1, context context.Context, d *schema.Reso
 	}
 	return nil
 }
+
+// resourceIbmIsShareOriginShareCustomizeDiff checks that a new file share sets profile and zone, unless it is an
+// accessor share, which inherits them from its origin share.
+func resourceIbmIsShareOriginShareCustomizeDiff(diff *schema.ResourceDiff) error {
+	if diff.Id() != "" {
+		return nil
+	}
+	config := diff.GetRawConfig()
+	if originShare := config.GetAttr("origin_share"); !originShare.IsNull() && (!originShare.IsKnown() || originShare.LengthInt() > 0) {
+		return nil
+	}
+	if config.GetAttr("profile").IsNull() || config.GetAttr("zone").IsNull() {
+		return fmt.Errorf("[ERROR] profile and zone are required unless origin_share is specified")
+	}
+	return nil
+}
+
+// shareAccessorOptions holds the accessor share and protocol properties of a file share.
+type shareAccessorOptions struct {
+	AccessorBindingRole *string `json:"accessor_binding_role,omitempty"`
+	AccessorBindings    []struct {
+		Href *string `json:"href,omitempty"`
+		ID   *string `json:"id,omitempty"`
+	} `json:"accessor_bindings,omitempty"`
+	OriginShare *struct {
+		CRN  *string `json:"crn,omitempty"`
+		Href *string `json:"href,omitempty"`
+		ID   *string `json:"id,omitempty"`
+		Name *string `json:"name,omitempty"`
+	} `json:"origin_share,omitempty"`
+	AllowedTransferProtocols      []string `json:"allowed_transfer_protocols,omitempty"`
+	AllowedTransitEncryptionModes []string `json:"allowed_transit_encryption_modes,omitempty"`
+}
+
+type shareAccessorPrototypeOptions struct {
+	OriginShare *struct {
+		CRN *string `json:"crn"`
+	} `json:"origin_share,omitempty"`
+	AllowedTransferProtocols      []string `json:"allowed_transfer_protocols,omitempty"`
+	AllowedTransitEncryptionModes []string `json:"allowed_transit_encryption_modes,omitempty"`
+}
+
+// shareAccessorPrototype wraps the prototype with the origin share and allowed protocols when any of them is configured.
+func shareAccessorPrototype(d *schema.ResourceData, prototype *vpcv1.SharePrototype) vpcv1.SharePrototypeIntf {
+	options := shareAccessorPrototypeOptions{}
+	remove := []string{}
+	configured := false
+	if originShareIntf, ok := d.GetOk("origin_share"); ok && originShareIntf.([]interface{})[0] != nil {
+		originShare := originShareIntf.([]interface{})[0].(map[string]interface{})
+		options.OriginShare = &struct {
+			CRN *string `json:"crn"`
+		}{CRN: core.StringPtr(originShare["crn"].(string))}
+		// profile and zone are required by the SDK model, an accessor share inherits them from its origin share
+		prototype.Profile = &vpcv1.ShareProfileIdentity{}
+		prototype.Zone = &vpcv1.ZoneIdentity{}
+		remove = append(remove, "profile", "zone")
+		configured = true
+	}
+	if protocols, ok := d.GetOk("allowed_transfer_protocols"); ok {
+		options.AllowedTransferProtocols = flex.ExpandStringList(protocols.(*schema.Set).List())
+		configured = true
+	}
+	if modes, ok := d.GetOk("allowed_transit_encryption_modes"); ok {
+		options.AllowedTransitEncryptionModes = flex.ExpandStringList(modes.(*schema.Set).List())
+		configured = true
+	}
+	if !configured {
+		return prototype
+	}
+	return &sharePrototypeWithRawFields{SharePrototypeIntf: prototype, vpcRawFields: vpcRawFields{fields: options, remove: remove}}
+}
+
+func shareAccessorOptionsPatch(d *schema.ResourceData) map[string]interface{} {
+	patch := map[string]interface{}{}
+	if d.HasChange("allowed_transfer_protocols") {
+		patch["allowed_transfer_protocols"] = flex.ExpandStringList(d.Get("allowed_transfer_protocols").(*schema.Set).List())
+	}
+	if d.HasChange("allowed_transit_encryption_modes") {
+		patch["allowed_transit_encryption_modes"] = flex.ExpandStringList(d.Get("allowed_transit_encryption_modes").(*schema.Set).List())
+	}
+	return patch
+}
+
+func getShareAccessorOptions(vpcClient *vpcv1.VpcV1, id string) (*shareAccessorOptions, error) {
+	options := &shareAccessorOptions{}
+	response, err := vpcRawGet(vpcClient, `/shares/{id}`, map[string]string{"id": id}, options)
+	if err != nil {
+		return nil, fmt.Errorf("[ERROR] Error getting file share (%s) accessor options: %s\n%s", id, err, response)
+	}
+	return options, nil
+}
+
+func setShareAccessorOptions(d *schema.ResourceData, options *shareAccessorOptions) error {
+	if options.AccessorBindingRole != nil {
+		d.Set("accessor_binding_role", *options.AccessorBindingRole)
+	}
+	accessorBindings := []map[string]interface{}{}
+	for _, binding := range options.AccessorBindings {
+		bindingMap := map[string]interface{}{}
+		if binding.Href != nil {
+			bindingMap["href"] = *binding.Href
+		}
+		if binding.ID != nil {
+			bindingMap["id"] = *binding.ID
+		}
+		accessorBindings = append(accessorBindings, bindingMap)
+	}
+	if err := d.Set("accessor_bindings", accessorBindings); err != nil {
+		return fmt.Errorf("Error setting accessor_bindings: %s", err)
+	}
+	if options.OriginShare != nil {
+		originShare := map[string]interface{}{}
+		if options.OriginShare.CRN != nil {
+			originShare["crn"] = *options.OriginShare.CRN
+		}
+		if options.OriginShare.Href != nil {
+			originShare["href"] = *options.OriginShare.Href
+		}
+		if options.OriginShare.ID != nil {
+			originShare["id"] = *options.OriginShare.ID
+		}
+		if options.OriginShare.Name != nil {
+			originShare["name"] = *options.OriginShare.Name
+		}
+		if err := d.Set("origin_share", []map[string]interface{}{originShare}); err != nil {
+			return fmt.Errorf("Error setting origin_share: %s", err)
+		}
+	}
+	if options.AllowedTransferProtocols != nil {
+		d.Set("allowed_transfer_protocols", options.AllowedTransferProtocols)
+	}
+	if options.AllowedTransitEncryptionModes != nil {
+		d.Set("allowed_transit_encryption_modes", options.AllowedTransitEncryptionModes)
+	}
+	return nil
+}
@@ -41,11 +41,25 @@ func ResourceIBMIsShareMountTarget() *schema.Resource {
 				Description:  "The user-defined name for this share target. Names must be unique within the share the share target resides in. If unspecified, the name will be a hyphenated list of randomly-selected words.",
 			},
 			"transit_encryption": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Computed:     true,
+				ValidateFunc: validate.InvokeValidator("ibm_is_share_mount_target", "transit_encryption"),
+				Description:  "The transit encryption mode. The mode must be one of the share's `allowed_transit_encryption_modes`.",
+			},
+			"transfer_protocol": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Computed:     true,
+				ValidateFunc: validate.InvokeValidator("ibm_is_share_mount_target", "transfer_protocol"),
+				Description:  "The transfer protocol to use for this mount target. The protocol must be one of the share's `allowed_transfer_protocols`.",
+			},
+			"access_protocol": {
 				Type:        schema.TypeString,
-				Optional:    true,
-				ForceNew:    true,
 				Computed:    true,
-				Description: "The transit encryption mode.",
+				Description: "The protocol to use to access the share for this mount target.",
 			},
 			"access_control_mode": {
 				Type:        schema.TypeString,
@@ -221,6 +235,20 @@ func ResourceIBMIsShareMountTargetValidator() *validate.ResourceValidator {
 			MinValueLength:             1,
 			MaxValueLength:             63,
 		},
+		validate.ValidateSchema{
+			Identifier:                 "transit_encryption",
+			ValidateFunctionIdentifier: validate.ValidateAllowedStringValue,
+			Type:                       validate.TypeString,
+			Optional:                   true,
+			AllowedValues:              "none, stunnel, user_managed",
+		},
+		validate.ValidateSchema{
+			Identifier:                 "transfer_protocol",
+			ValidateFunctionIdentifier: validate.ValidateAllowedStringValue,
+			Type:                       validate.TypeString,
+			Optional:                   true,
+			AllowedValues:              "tcp, udp",
+		},
 	)
 
 	resourceValidator := validate.ResourceValidator{ResourceName: "ibm_is_share_mount_target", Schema: validateSchema}
@@ -309,6 +337,13 @@ func resourceIBMIsShareMountTargetCreate(context context.Context, d *schema.Reso
 		shareMountTargetPrototype.TransitEncryption = &transitEncryption
 	}
 	createShareMountTargetOptions.ShareMountTargetPrototype = shareMountTargetPrototype
+	if transferProtocolIntf, ok := d.GetOk("transfer_protocol"); ok {
+		transferProtocol := transferProtocolIntf.(string)
+		createShareMountTargetOptions.ShareMountTargetPrototype = &shareMountTargetPrototypeWithRawFields{
+			ShareMountTargetPrototypeIntf: shareMountTargetPrototype,
+			vpcRawFields:                  vpcRawFields{fields: shareMountTargetProtocols{TransferProtocol: &transferProtocol}},
+		}
+	}
 	shareTarget, response, err := vpcClient.CreateShareMountTargetWithContext(context, createShareMountTargetOptions)
 	if err != nil || shareTarget == nil {
 		log.Printf("[DEBUG] CreateShareMountTargetWithContext failed %s\n%s", err, response)
@@ -381,6 +416,19 @@ func resourceIBMIsShareMountTargetRead(context context.Context, d *schema.Resour
 		}
 	}
 
+	protocols := &shareMountTargetProtocols{}
+	response, err = vpcRawGet(vpcClient, `/shares/{share_id}/mount_targets/{id}`, map[string]string{"share_id": parts[0], "id": parts[1]}, protocols)
+	if err != nil {
+		log.Printf("[DEBUG] Getting share mount target protocols failed %s\n%s", err, response)
+		return diag.FromErr(err)
+	}
+	if protocols.AccessProtocol != nil {
+		d.Set("access_protocol", *protocols.AccessProtocol)
+	}
+	if protocols.TransferProtocol != nil {
+		d.Set("transfer_protocol", *protocols.TransferProtocol)
+	}
+
 	if err = d.Set("created_at", shareTarget.CreatedAt.String()); err != nil {
 		return diag.FromErr(fmt.Errorf("Error setting created_at: %s", err))
 	}
@@ -895,3 +943,9 @@ func mountTargetRefreshFunc(context context.Context, vpcClient *vpcv1.VpcV1, sha
 		return target, "pending", nil
 	}
 }
+
+// shareMountTargetProtocols holds the access and transfer protocols of a share mount target.
+type shareMountTargetProtocols struct {
+	AccessProtocol   *string `json:"access_protocol,omitempty"`
+	TransferProtocol *string `json:"transfer_protocol,omitempty"`
+}
@@ -5,6 +5,7 @@ package vpc_test
 
 import (
 	"fmt"
+	"regexp"
 	"strconv"
 	"testing"
 
@@ -38,6 +39,26 @@ func TestAccIbmIsShareBasic(t *testing.T) {
 	})
 }
 
+func TestAccIbmIsShareProfileRequired(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+	resource "ibm_is_share" "is_share" {
+		name = "tf-fs-no-profile"
+		size = 200
+		zone = "us-south-2"
+	}
+	`,
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("profile and zone are required unless origin_share is specified"),
+			},
+		},
+	})
+}
+
 func TestAccIbmIsShareCrossRegionReplication(t *testing.T) {
 	var conf vpcv1.Share
 	name := fmt.Sprintf("tf-fs-name-%d", acctest.RandIntRange(10, 100))
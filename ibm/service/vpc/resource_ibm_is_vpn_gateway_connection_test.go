@@ -6,6 +6,7 @@ package vpc_test
 import (
 	"errors"
 	"fmt"
+	"regexp"
 	"testing"
 
 	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
@@ -486,3 +487,110 @@ func testAccCheckIBMISVPNGatewayConnectionNullPatchConfig(vpc, subnet, vpnname,
 	`, vpc, subnet, acc.ISZoneName, acc.ISCIDR, vpnname, ikepolicyname, ipsecpolicyname, name, noNullPass, noNullPass)
 
 }
+
+func TestAccIBMISVPNGatewayConnection_localPeer(t *testing.T) {
+	var VPNGatewayConnection string
+	vpcname := fmt.Sprintf("tfvpngc-vpc-%d", acctest.RandIntRange(100, 200))
+	subnetname := fmt.Sprintf("tfvpngc-subnet-%d", acctest.RandIntRange(100, 200))
+	vpnname := fmt.Sprintf("tfvpngc-vpn-%d", acctest.RandIntRange(100, 200))
+	name := fmt.Sprintf("tfvpngc-createname-%d", acctest.RandIntRange(100, 200))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckIBMISVPNGatewayConnectionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMISVPNGatewayConnectionLocalPeerConfig(vpcname, subnetname, vpnname, name, "peer1.example.com"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIBMISVPNGatewayConnectionExists("ibm_is_vpn_gateway_connection.testacc_VPNGatewayConnection", VPNGatewayConnection),
+					resource.TestCheckResourceAttr(
+						"ibm_is_vpn_gateway_connection.testacc_VPNGatewayConnection", "peer.0.fqdn", "peer1.example.com"),
+					resource.TestCheckResourceAttr(
+						"ibm_is_vpn_gateway_connection.testacc_VPNGatewayConnection", "peer.0.type", "fqdn"),
+					resource.TestCheckResourceAttr(
+						"ibm_is_vpn_gateway_connection.testacc_VPNGatewayConnection", "peer.0.ike_identity.0.type", "fqdn"),
+					resource.TestCheckResourceAttr(
+						"ibm_is_vpn_gateway_connection.testacc_VPNGatewayConnection", "local.0.ike_identities.#", "2"),
+					resource.TestCheckResourceAttr(
+						"ibm_is_vpn_gateway_connection.testacc_VPNGatewayConnection", "distribute_traffic", "true"),
+				),
+			},
+			{
+				Config: testAccCheckIBMISVPNGatewayConnectionLocalPeerConfig(vpcname, subnetname, vpnname, name, "peer2.example.com"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"ibm_is_vpn_gateway_connection.testacc_VPNGatewayConnection", "peer.0.fqdn", "peer2.example.com"),
+					resource.TestCheckResourceAttr(
+						"ibm_is_vpn_gateway_connection.testacc_VPNGatewayConnection", "peer.0.ike_identity.0.value", "peer2.example.com"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccIBMISVPNGatewayConnection_peerAddressAndFqdn(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+	resource "ibm_is_vpn_gateway_connection" "testacc_VPNGatewayConnection" {
+		name = "tfvpngc-peer"
+		vpn_gateway = "r006-00000000-0000-0000-0000-000000000000"
+		preshared_key = "VPNDemoPassword"
+		peer {
+			address = "10.0.0.1"
+			fqdn = "peer1.example.com"
+		}
+	}
+	`,
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("Exactly one of peer.0.address or peer.0.fqdn must be specified"),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMISVPNGatewayConnectionLocalPeerConfig(vpc, subnet, vpnname, name, fqdn string) string {
+	return fmt.Sprintf(`
+	resource "ibm_is_vpc" "testacc_vpc" {
+		name = "%s"
+	}
+	resource "ibm_is_subnet" "testacc_subnet" {
+		name = "%s"
+		vpc = ibm_is_vpc.testacc_vpc.id
+		zone = "%s"
+		ipv4_cidr_block = "%s"
+	}
+	resource "ibm_is_vpn_gateway" "testacc_VPNGateway" {
+		name = "%s"
+		subnet = ibm_is_subnet.testacc_subnet.id
+		mode = "route"
+	}
+	resource "ibm_is_vpn_gateway_connection" "testacc_VPNGatewayConnection" {
+		name = "%s"
+		vpn_gateway = ibm_is_vpn_gateway.testacc_VPNGateway.id
+		preshared_key = "VPNDemoPassword"
+		distribute_traffic = true
+		local {
+			ike_identities {
+				type = "fqdn"
+				value = "member1.example.com"
+			}
+			ike_identities {
+				type = "fqdn"
+				value = "member2.example.com"
+			}
+		}
+		peer {
+			fqdn = "%s"
+			ike_identity {
+				type = "fqdn"
+				value = "%s"
+			}
+		}
+	}
+	`, vpc, subnet, acc.ISZoneName, acc.ISCIDR, vpnname, name, fqdn, fqdn)
+}
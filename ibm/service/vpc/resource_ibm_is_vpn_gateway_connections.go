@@ -4,13 +4,17 @@
 package vpc
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"time"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	"github.com/IBM/go-sdk-core/v5/core"
 	"github.com/IBM/vpc-go-sdk/vpcv1"
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -40,6 +44,14 @@ const (
 	isVPNGatewayConnectionResourcetype              = "resource_type"
 	isVPNGatewayConnectionCreatedat                 = "created_at"
 	isVPNGatewayConnectionStatusreasons             = "status_reasons"
+	isVPNGatewayConnectionLocal                     = "local"
+	isVPNGatewayConnectionPeer                      = "peer"
+	isVPNGatewayConnectionCIDRs                     = "cidrs"
+	isVPNGatewayConnectionIkeIdentities             = "ike_identities"
+	isVPNGatewayConnectionIkeIdentity               = "ike_identity"
+	isVPNGatewayConnectionPeerFqdn                  = "fqdn"
+	isVPNGatewayConnectionPeerAddressKey            = "address"
+	isVPNGatewayConnectionDistributeTraffic         = "distribute_traffic"
 )
 
 func ResourceIBMISVPNGatewayConnection() *schema.Resource {
@@ -55,6 +67,12 @@ func ResourceIBMISVPNGatewayConnection() *schema.Resource {
 			Delete: schema.DefaultTimeout(10 * time.Minute),
 		},
 
+		CustomizeDiff: customdiff.Sequence(
+			func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
+				return resourceIBMISVPNGatewayConnectionPeerCustomizeDiff(diff)
+			},
+		),
+
 		Schema: map[string]*schema.Schema{
 
 			isVPNGatewayConnectionName: {
@@ -72,9 +90,11 @@ func ResourceIBMISVPNGatewayConnection() *schema.Resource {
 			},
 
 			isVPNGatewayConnectionPeerAddress: {
-				Type:        schema.TypeString,
-				Required:    true,
-				Description: "VPN gateway connection peer address",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{isVPNGatewayConnectionPeerAddress, isVPNGatewayConnectionPeer},
+				Description:  "VPN gateway connection peer address",
 			},
 
 			isVPNGatewayConnectionPreSharedKey: {
@@ -91,21 +111,114 @@ func ResourceIBMISVPNGatewayConnection() *schema.Resource {
 			},
 
 			isVPNGatewayConnectionLocalCIDRS: {
-				Type:        schema.TypeSet,
-				Optional:    true,
-				ForceNew:    true,
-				Elem:        &schema.Schema{Type: schema.TypeString},
-				Set:         schema.HashString,
-				Description: "VPN gateway connection local CIDRs",
+				Type:          schema.TypeSet,
+				Optional:      true,
+				Computed:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{isVPNGatewayConnectionLocal},
+				Elem:          &schema.Schema{Type: schema.TypeString},
+				Set:           schema.HashString,
+				Description:   "VPN gateway connection local CIDRs",
 			},
 
 			isVPNGatewayConnectionPeerCIDRS: {
-				Type:        schema.TypeSet,
+				Type:          schema.TypeSet,
+				Optional:      true,
+				Computed:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{isVPNGatewayConnectionPeer},
+				Elem:          &schema.Schema{Type: schema.TypeString},
+				Set:           schema.HashString,
+				Description:   "VPN gateway connection peer CIDRs",
+			},
+
+			isVPNGatewayConnectionLocal: {
+				Type:          schema.TypeList,
+				Optional:      true,
+				Computed:      true,
+				MaxItems:      1,
+				ConflictsWith: []string{isVPNGatewayConnectionLocalCIDRS},
+				Description:   "The local configuration of the VPN gateway connection",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						isVPNGatewayConnectionCIDRs: {
+							Type:        schema.TypeSet,
+							Optional:    true,
+							Computed:    true,
+							ForceNew:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Set:         schema.HashString,
+							Description: "The local CIDRs for this resource",
+						},
+						isVPNGatewayConnectionIkeIdentities: {
+							Type:        schema.TypeList,
+							Optional:    true,
+							Computed:    true,
+							MaxItems:    2,
+							Description: "The local IKE identities. A VPN gateway in static route mode consists of two members in active-active mode, the first identity applies to the first member and the second identity to the second member",
+							Elem: &schema.Resource{
+								Schema: vpnGatewayConnectionIkeIdentitySchema(),
+							},
+						},
+					},
+				},
+			},
+
+			isVPNGatewayConnectionPeer: {
+				Type:          schema.TypeList,
+				Optional:      true,
+				Computed:      true,
+				MaxItems:      1,
+				ExactlyOneOf:  []string{isVPNGatewayConnectionPeerAddress, isVPNGatewayConnectionPeer},
+				ConflictsWith: []string{isVPNGatewayConnectionPeerCIDRS},
+				Description:   "The peer configuration of the VPN gateway connection",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						isVPNGatewayConnectionPeerAddressKey: {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Computed:    true,
+							Description: "The IP address of the peer VPN gateway for this connection",
+						},
+						isVPNGatewayConnectionPeerFqdn: {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Computed:    true,
+							Description: "The FQDN of the peer VPN gateway for this connection",
+						},
+						isVPNGatewayConnectionCIDRs: {
+							Type:        schema.TypeSet,
+							Optional:    true,
+							Computed:    true,
+							ForceNew:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Set:         schema.HashString,
+							Description: "The peer CIDRs for this resource",
+						},
+						isVPNGatewayConnectionIkeIdentity: {
+							Type:        schema.TypeList,
+							Optional:    true,
+							Computed:    true,
+							MaxItems:    1,
+							Description: "The peer IKE identity",
+							Elem: &schema.Resource{
+								Schema: vpnGatewayConnectionIkeIdentitySchema(),
+							},
+						},
+						"type": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Indicates whether `peer.address` or `peer.fqdn` is used",
+						},
+					},
+				},
+			},
+
+			isVPNGatewayConnectionDistributeTraffic: {
+				Type:        schema.TypeBool,
 				Optional:    true,
-				ForceNew:    true,
-				Elem:        &schema.Schema{Type: schema.TypeString},
-				Set:         schema.HashString,
-				Description: "VPN gateway connection peer CIDRs",
+				Computed:    true,
+				Description: "Indicates whether the traffic is distributed between the `up` tunnels of the VPN gateway connection when the VPC route's next hop is a VPN connection. Only applicable to connections of VPN gateways in static route mode",
 			},
 
 			isVPNGatewayConnectionDeadPeerDetectionAction: {
@@ -267,6 +380,14 @@ func ResourceIBMISVPNGatewayConnectionValidator() *validate.ResourceValidator {
 			MinValue:                   "2",
 			MaxValue:                   "86399"})
 
+	validateSchema = append(validateSchema,
+		validate.ValidateSchema{
+			Identifier:                 isVPNGatewayConnectionIkeIdentity,
+			ValidateFunctionIdentifier: validate.ValidateAllowedStringValue,
+			Type:                       validate.TypeString,
+			Optional:                   true,
+			AllowedValues:              "fqdn, hostname, ipv4_address, key_id"})
+
 	ibmISVPNGatewayConnectionResourceValidator := validate.ResourceValidator{ResourceName: "ibm_is_vpn_gateway_connection", Schema: validateSchema}
 	return &ibmISVPNGatewayConnectionResourceValidator
 }
@@ -359,6 +480,10 @@ func vpngwconCreate(d *schema.ResourceData, meta interface{}, name, gatewayID, p
 		vpnGatewayConnectionPrototypeModel.IpsecPolicy = nil
 	}
 
+	if prototype, ok := expandVPNGatewayConnectionEndpoints(d, vpnGatewayConnectionPrototypeModel); ok {
+		options.VPNGatewayConnectionPrototype = prototype
+	}
+
 	vpnGatewayConnectionIntf, response, err := sess.CreateVPNGatewayConnection(options)
 	if err != nil {
 		return fmt.Errorf("[DEBUG] Create VPN Gateway Connection err %s\n%s", err, response)
@@ -408,7 +533,9 @@ func vpngwconGet(d *schema.ResourceData, meta interface{}, gID, gConnID string)
 	d.Set(isVPNGatewayConnectionName, *vpnGatewayConnection.Name)
 	d.Set(isVPNGatewayConnectionVPNGateway, gID)
 	d.Set(isVPNGatewayConnectionAdminStateup, *vpnGatewayConnection.AdminStateUp)
-	d.Set(isVPNGatewayConnectionPeerAddress, *vpnGatewayConnection.PeerAddress)
+	if vpnGatewayConnection.PeerAddress != nil {
+		d.Set(isVPNGatewayConnectionPeerAddress, *vpnGatewayConnection.PeerAddress)
+	}
 	d.Set(isVPNGatewayConnectionPreSharedKey, *vpnGatewayConnection.Psk)
 
 	if vpnGatewayConnection.LocalCIDRs != nil {
@@ -458,6 +585,15 @@ func vpngwconGet(d *schema.ResourceData, meta interface{}, gID, gConnID string)
 	}
 	d.Set(isVPNGatewayConnectionTunnels, vpcTunnelsList)
 
+	endpoints := &vpnGatewayConnectionEndpoints{}
+	response, err = vpcRawGet(sess, `/vpn_gateways/{vpn_gateway_id}/connections/{id}`, map[string]string{"vpn_gateway_id": gID, "id": gConnID}, endpoints)
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error Getting Vpn Gateway Connection (%s) local and peer configuration: %s\n%s", gConnID, err, response)
+	}
+	if err = setVPNGatewayConnectionEndpoints(d, endpoints); err != nil {
+		return err
+	}
+
 	d.Set(isVPNGatewayConnectionDeadPeerDetectionAction, *vpnGatewayConnection.DeadPeerDetection.Action)
 	d.Set(isVPNGatewayConnectionDeadPeerDetectionInterval, *vpnGatewayConnection.DeadPeerDetection.Interval)
 	d.Set(isVPNGatewayConnectionDeadPeerDetectionTimeout, *vpnGatewayConnection.DeadPeerDetection.Timeout)
@@ -507,7 +643,7 @@ func vpngwconUpdate(d *schema.ResourceData, meta interface{}, gID, gConnID strin
 		hasChanged = true
 	}
 
-	if d.HasChange(isVPNGatewayConnectionPeerAddress) {
+	if d.HasChange(isVPNGatewayConnectionPeerAddress) && !d.HasChange(isVPNGatewayConnectionPeer) {
 		peerAddress := d.Get(isVPNGatewayConnectionPeerAddress).(string)
 		vpnGatewayConnectionPatchModel.PeerAddress = &peerAddress
 		hasChanged = true
@@ -569,11 +705,19 @@ func vpngwconUpdate(d *schema.ResourceData, meta interface{}, gID, gConnID strin
 		hasChanged = true
 	}
 
+	endpointsPatch := vpnGatewayConnectionEndpointsPatch(d)
+	if len(endpointsPatch) > 0 {
+		hasChanged = true
+	}
+
 	if hasChanged {
 		vpnGatewayConnectionPatch, err := vpnGatewayConnectionPatchModel.AsPatch()
 		if err != nil {
 			return fmt.Errorf("[ERROR] Error calling asPatch for VPNGatewayConnectionPatch: %s", err)
 		}
+		for key, value := range endpointsPatch {
+			vpnGatewayConnectionPatch[key] = value
+		}
 		updateVpnGatewayConnectionOptions.VPNGatewayConnectionPatch = vpnGatewayConnectionPatch
 		_, response, err := sess.UpdateVPNGatewayConnection(updateVpnGatewayConnectionOptions)
 		if err != nil {
@@ -720,3 +864,227 @@ func resourceVPNGatewayConnectionFlattenLifecycleReasons(statusReasons []vpcv1.V
 	}
 	return statusReasonsList
 }
+
+func vpnGatewayConnectionIkeIdentitySchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"type": {
+			Type:         schema.TypeString,
+			Required:     true,
+			ValidateFunc: validate.InvokeValidator("ibm_is_vpn_gateway_connection", isVPNGatewayConnectionIkeIdentity),
+			Description:  "The IKE identity type: fqdn, hostname, ipv4_address or key_id",
+		},
+		"value": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Computed:    true,
+			Description: "The IKE identity value. For ipv4_address identities of local members, the value defaults to the public IP address of the member",
+		},
+	}
+}
+
+type vpnGatewayConnectionIkeIdentity struct {
+	Type  *string `json:"type"`
+	Value *string `json:"value,omitempty"`
+}
+
+// resourceIBMISVPNGatewayConnectionPeerCustomizeDiff checks that a configured peer block sets exactly one of address or fqdn.
+func resourceIBMISVPNGatewayConnectionPeerCustomizeDiff(diff *schema.ResourceDiff) error {
+	peers := diff.GetRawConfig().GetAttr(isVPNGatewayConnectionPeer)
+	if peers.IsNull() || !peers.IsKnown() || peers.LengthInt() == 0 {
+		return nil
+	}
+	peer := peers.Index(cty.NumberIntVal(0))
+	if peer.IsNull() || !peer.IsKnown() {
+		return nil
+	}
+	address, fqdn := peer.GetAttr(isVPNGatewayConnectionPeerAddressKey), peer.GetAttr(isVPNGatewayConnectionPeerFqdn)
+	if !address.IsKnown() || !fqdn.IsKnown() {
+		return nil
+	}
+	if address.IsNull() == fqdn.IsNull() {
+		return fmt.Errorf("[ERROR] Exactly one of %s.0.%s or %s.0.%s must be specified", isVPNGatewayConnectionPeer, isVPNGatewayConnectionPeerAddressKey, isVPNGatewayConnectionPeer, isVPNGatewayConnectionPeerFqdn)
+	}
+	return nil
+}
+
+// vpnGatewayConnectionEndpoints holds the local and peer objects and distribute_traffic of a VPN gateway connection.
+type vpnGatewayConnectionEndpoints struct {
+	DistributeTraffic *bool `json:"distribute_traffic,omitempty"`
+	Local             *struct {
+		CIDRs         []string                          `json:"cidrs,omitempty"`
+		IkeIdentities []vpnGatewayConnectionIkeIdentity `json:"ike_identities,omitempty"`
+	} `json:"local,omitempty"`
+	Peer *struct {
+		Address     *string                          `json:"address,omitempty"`
+		Fqdn        *string                          `json:"fqdn,omitempty"`
+		Type        *string                          `json:"type,omitempty"`
+		CIDRs       []string                         `json:"cidrs,omitempty"`
+		IkeIdentity *vpnGatewayConnectionIkeIdentity `json:"ike_identity,omitempty"`
+	} `json:"peer,omitempty"`
+}
+
+func expandVPNGatewayConnectionIkeIdentity(identity map[string]interface{}) vpnGatewayConnectionIkeIdentity {
+	ikeIdentity := vpnGatewayConnectionIkeIdentity{
+		Type: core.StringPtr(identity["type"].(string)),
+	}
+	if value, ok := identity["value"].(string); ok && value != "" {
+		ikeIdentity.Value = &value
+	}
+	return ikeIdentity
+}
+
+// expandVPNGatewayConnectionEndpoints wraps the prototype with the local and peer blocks and distribute_traffic when any of them is configured.
+func expandVPNGatewayConnectionEndpoints(d *schema.ResourceData, prototype *vpcv1.VPNGatewayConnectionPrototype) (vpcv1.VPNGatewayConnectionPrototypeIntf, bool) {
+	endpoints := vpnGatewayConnectionEndpoints{}
+	configured := false
+	if local, ok := d.GetOk(isVPNGatewayConnectionLocal); ok && len(local.([]interface{})) > 0 && local.([]interface{})[0] != nil {
+		localMap := local.([]interface{})[0].(map[string]interface{})
+		endpoints.Local = &struct {
+			CIDRs         []string                          `json:"cidrs,omitempty"`
+			IkeIdentities []vpnGatewayConnectionIkeIdentity `json:"ike_identities,omitempty"`
+		}{}
+		if cidrs, ok := localMap[isVPNGatewayConnectionCIDRs].(*schema.Set); ok {
+			endpoints.Local.CIDRs = flex.ExpandStringList(cidrs.List())
+		}
+		for _, identity := range localMap[isVPNGatewayConnectionIkeIdentities].([]interface{}) {
+			endpoints.Local.IkeIdentities = append(endpoints.Local.IkeIdentities, expandVPNGatewayConnectionIkeIdentity(identity.(map[string]interface{})))
+		}
+		configured = true
+	}
+	if peer, ok := d.GetOk(isVPNGatewayConnectionPeer); ok && len(peer.([]interface{})) > 0 && peer.([]interface{})[0] != nil {
+		peerMap := peer.([]interface{})[0].(map[string]interface{})
+		endpoints.Peer = &struct {
+			Address     *string                          `json:"address,omitempty"`
+			Fqdn        *string                          `json:"fqdn,omitempty"`
+			Type        *string                          `json:"type,omitempty"`
+			CIDRs       []string                         `json:"cidrs,omitempty"`
+			IkeIdentity *vpnGatewayConnectionIkeIdentity `json:"ike_identity,omitempty"`
+		}{}
+		if address, ok := peerMap[isVPNGatewayConnectionPeerAddressKey].(string); ok && address != "" {
+			endpoints.Peer.Address = &address
+			prototype.PeerAddress = &address
+		} else if fqdn, ok := peerMap[isVPNGatewayConnectionPeerFqdn].(string); ok && fqdn != "" {
+			endpoints.Peer.Fqdn = &fqdn
+			// peer_address is required by the SDK model and is removed from the request body
+			prototype.PeerAddress = &fqdn
+		}
+		if cidrs, ok := peerMap[isVPNGatewayConnectionCIDRs].(*schema.Set); ok {
+			endpoints.Peer.CIDRs = flex.ExpandStringList(cidrs.List())
+		}
+		if identities := peerMap[isVPNGatewayConnectionIkeIdentity].([]interface{}); len(identities) > 0 && identities[0] != nil {
+			identity := expandVPNGatewayConnectionIkeIdentity(identities[0].(map[string]interface{}))
+			endpoints.Peer.IkeIdentity = &identity
+		}
+		configured = true
+	}
+	if distributeTraffic, ok := d.GetOkExists(isVPNGatewayConnectionDistributeTraffic); ok {
+		endpoints.DistributeTraffic = core.BoolPtr(distributeTraffic.(bool))
+		configured = true
+	}
+	if !configured {
+		return prototype, false
+	}
+	// the local and peer objects replace the flat local_cidrs, peer_address and peer_cidrs properties
+	remove := []string{}
+	if endpoints.Local != nil {
+		remove = append(remove, isVPNGatewayConnectionLocalCIDRS)
+	}
+	if endpoints.Peer != nil {
+		remove = append(remove, isVPNGatewayConnectionPeerAddress, isVPNGatewayConnectionPeerCIDRS)
+	}
+	return &vpnGatewayConnectionPrototypeWithRawFields{VPNGatewayConnectionPrototypeIntf: prototype, vpcRawFields: vpcRawFields{fields: endpoints, remove: remove}}, true
+}
+
+// vpnGatewayConnectionEndpointsPatch returns the patch entries for the updatable parts of the local and peer blocks and distribute_traffic.
+func vpnGatewayConnectionEndpointsPatch(d *schema.ResourceData) map[string]interface{} {
+	patch := map[string]interface{}{}
+	if d.HasChange(isVPNGatewayConnectionLocal + ".0." + isVPNGatewayConnectionIkeIdentities) {
+		identities := []vpnGatewayConnectionIkeIdentity{}
+		for _, identity := range d.Get(isVPNGatewayConnectionLocal + ".0." + isVPNGatewayConnectionIkeIdentities).([]interface{}) {
+			identities = append(identities, expandVPNGatewayConnectionIkeIdentity(identity.(map[string]interface{})))
+		}
+		patch[isVPNGatewayConnectionLocal] = map[string]interface{}{
+			isVPNGatewayConnectionIkeIdentities: identities,
+		}
+	}
+	peerPrefix := isVPNGatewayConnectionPeer + ".0."
+	if d.HasChange(peerPrefix+isVPNGatewayConnectionPeerAddressKey) || d.HasChange(peerPrefix+isVPNGatewayConnectionPeerFqdn) || d.HasChange(peerPrefix+isVPNGatewayConnectionIkeIdentity) {
+		peer := map[string]interface{}{}
+		if address := d.Get(peerPrefix + isVPNGatewayConnectionPeerAddressKey).(string); address != "" && d.HasChange(peerPrefix+isVPNGatewayConnectionPeerAddressKey) {
+			peer[isVPNGatewayConnectionPeerAddressKey] = address
+		} else if fqdn := d.Get(peerPrefix + isVPNGatewayConnectionPeerFqdn).(string); fqdn != "" && d.HasChange(peerPrefix+isVPNGatewayConnectionPeerFqdn) {
+			peer[isVPNGatewayConnectionPeerFqdn] = fqdn
+		}
+		if d.HasChange(peerPrefix + isVPNGatewayConnectionIkeIdentity) {
+			if identities := d.Get(peerPrefix + isVPNGatewayConnectionIkeIdentity).([]interface{}); len(identities) > 0 && identities[0] != nil {
+				peer[isVPNGatewayConnectionIkeIdentity] = expandVPNGatewayConnectionIkeIdentity(identities[0].(map[string]interface{}))
+			}
+		}
+		if len(peer) > 0 {
+			patch[isVPNGatewayConnectionPeer] = peer
+		}
+	}
+	if d.HasChange(isVPNGatewayConnectionDistributeTraffic) {
+		patch[isVPNGatewayConnectionDistributeTraffic] = d.Get(isVPNGatewayConnectionDistributeTraffic).(bool)
+	}
+	return patch
+}
+
+func flattenVPNGatewayConnectionIkeIdentity(identity vpnGatewayConnectionIkeIdentity) map[string]interface{} {
+	identityMap := map[string]interface{}{}
+	if identity.Type != nil {
+		identityMap["type"] = *identity.Type
+	}
+	if identity.Value != nil {
+		identityMap["value"] = *identity.Value
+	}
+	return identityMap
+}
+
+// setVPNGatewayConnectionEndpoints sets the local and peer blocks and keeps the flat attributes populated for existing configurations.
+func setVPNGatewayConnectionEndpoints(d *schema.ResourceData, endpoints *vpnGatewayConnectionEndpoints) error {
+	if endpoints.DistributeTraffic != nil {
+		d.Set(isVPNGatewayConnectionDistributeTraffic, *endpoints.DistributeTraffic)
+	}
+	if endpoints.Local != nil {
+		identities := []map[string]interface{}{}
+		for _, identity := range endpoints.Local.IkeIdentities {
+			identities = append(identities, flattenVPNGatewayConnectionIkeIdentity(identity))
+		}
+		local := map[string]interface{}{
+			isVPNGatewayConnectionCIDRs:         flex.FlattenStringList(endpoints.Local.CIDRs),
+			isVPNGatewayConnectionIkeIdentities: identities,
+		}
+		if err := d.Set(isVPNGatewayConnectionLocal, []interface{}{local}); err != nil {
+			return fmt.Errorf("[ERROR] Error setting local: %s", err)
+		}
+		if endpoints.Local.CIDRs != nil {
+			d.Set(isVPNGatewayConnectionLocalCIDRS, flex.FlattenStringList(endpoints.Local.CIDRs))
+		}
+	}
+	if endpoints.Peer != nil {
+		peer := map[string]interface{}{
+			isVPNGatewayConnectionCIDRs: flex.FlattenStringList(endpoints.Peer.CIDRs),
+		}
+		if endpoints.Peer.Address != nil {
+			peer[isVPNGatewayConnectionPeerAddressKey] = *endpoints.Peer.Address
+			d.Set(isVPNGatewayConnectionPeerAddress, *endpoints.Peer.Address)
+		}
+		if endpoints.Peer.Fqdn != nil {
+			peer[isVPNGatewayConnectionPeerFqdn] = *endpoints.Peer.Fqdn
+		}
+		if endpoints.Peer.Type != nil {
+			peer["type"] = *endpoints.Peer.Type
+		}
+		if endpoints.Peer.IkeIdentity != nil {
+			peer[isVPNGatewayConnectionIkeIdentity] = []map[string]interface{}{flattenVPNGatewayConnectionIkeIdentity(*endpoints.Peer.IkeIdentity)}
+		}
+		if err := d.Set(isVPNGatewayConnectionPeer, []interface{}{peer}); err != nil {
+			return fmt.Errorf("[ERROR] Error setting peer: %s", err)
+		}
+		if endpoints.Peer.CIDRs != nil {
+			d.Set(isVPNGatewayConnectionPeerCIDRS, flex.FlattenStringList(endpoints.Peer.CIDRs))
+		}
+	}
+	return nil
+}
@@ -0,0 +1,118 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package vpc
+
+import (
+	"encoding/json"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/vpc-go-sdk/vpcv1"
+)
+
+// Confidential computing and secure boot of instances, the local and peer objects of VPN gateway connections,
+// accessor shares, share mount target protocols, share accessor bindings and pool member health reasons are newer
+// than the vpc-go-sdk version in use, and the SDK versions that model them change the existing models incompatibly.
+// Until the SDK is upgraded, the prototypes of the SDK are wrapped to add these properties to the request bodies,
+// and the properties are read with vpcRawGet.
+
+// vpcRawFields changes the JSON body of an SDK model: the properties of fields, which must marshal to a JSON
+// object, are added to the body and the properties named in remove are removed from it.
+type vpcRawFields struct {
+	fields interface{}
+	remove []string
+}
+
+func (raw vpcRawFields) marshal(model interface{}) ([]byte, error) {
+	body, err := json.Marshal(model)
+	if err != nil {
+		return nil, err
+	}
+	properties := map[string]json.RawMessage{}
+	if err = json.Unmarshal(body, &properties); err != nil {
+		return nil, err
+	}
+	for _, name := range raw.remove {
+		delete(properties, name)
+	}
+	if raw.fields != nil {
+		body, err = json.Marshal(raw.fields)
+		if err != nil {
+			return nil, err
+		}
+		if err = json.Unmarshal(body, &properties); err != nil {
+			return nil, err
+		}
+	}
+	return json.Marshal(properties)
+}
+
+type instancePrototypeWithRawFields struct {
+	vpcv1.InstancePrototypeIntf
+	vpcRawFields
+}
+
+func (prototype *instancePrototypeWithRawFields) MarshalJSON() ([]byte, error) {
+	return prototype.marshal(prototype.InstancePrototypeIntf)
+}
+
+type instanceTemplatePrototypeWithRawFields struct {
+	vpcv1.InstanceTemplatePrototypeIntf
+	vpcRawFields
+}
+
+func (prototype *instanceTemplatePrototypeWithRawFields) MarshalJSON() ([]byte, error) {
+	return prototype.marshal(prototype.InstanceTemplatePrototypeIntf)
+}
+
+type vpnGatewayConnectionPrototypeWithRawFields struct {
+	vpcv1.VPNGatewayConnectionPrototypeIntf
+	vpcRawFields
+}
+
+func (prototype *vpnGatewayConnectionPrototypeWithRawFields) MarshalJSON() ([]byte, error) {
+	return prototype.marshal(prototype.VPNGatewayConnectionPrototypeIntf)
+}
+
+type sharePrototypeWithRawFields struct {
+	vpcv1.SharePrototypeIntf
+	vpcRawFields
+}
+
+func (prototype *sharePrototypeWithRawFields) MarshalJSON() ([]byte, error) {
+	return prototype.marshal(prototype.SharePrototypeIntf)
+}
+
+type shareMountTargetPrototypeWithRawFields struct {
+	vpcv1.ShareMountTargetPrototypeIntf
+	vpcRawFields
+}
+
+func (prototype *shareMountTargetPrototypeWithRawFields) MarshalJSON() ([]byte, error) {
+	return prototype.marshal(prototype.ShareMountTargetPrototypeIntf)
+}
+
+// vpcRawGet gets the resource at path and unmarshals the response body into result.
+func vpcRawGet(sess *vpcv1.VpcV1, path string, pathParams map[string]string, result interface{}) (*core.DetailedResponse, error) {
+	return vpcRawGetWithQuery(sess, path, pathParams, nil, result)
+}
+
+func vpcRawGetWithQuery(sess *vpcv1.VpcV1, path string, pathParams, queryParams map[string]string, result interface{}) (*core.DetailedResponse, error) {
+	builder := core.NewRequestBuilder(core.GET)
+	builder.EnableGzipCompression = sess.GetEnableGzipCompression()
+	_, err := builder.ResolveRequestURL(sess.Service.Options.URL, path, pathParams)
+	if err != nil {
+		return nil, err
+	}
+	builder.AddHeader("Accept", "application/json")
+	builder.AddQuery("version", *sess.Version)
+	builder.AddQuery("generation", "2")
+	for key, value := range queryParams {
+		builder.AddQuery(key, value)
+	}
+	request, err := builder.Build()
+	if err != nil {
+		return nil, err
+	}
+	return sess.Service.Request(request, result)
+}